@@ -96,15 +96,100 @@ func (v1 Uncertain) div(v2 Uncertain) (quotient Uncertain) {
 	return v1.mul(v2)
 }
 
-// TODO
-//func Cbrt(x float64) float64
+// Cbrt returns the cube root of v.Value and propagates error.
+//
+// Special cases are:
+//
+//	Cbrt({0, e}) = {0, cbrt(e)} - derivative is infinite at zero, so error is calculated from the interval.
+//	Cbrt({±Inf, e}) = {±Inf, 0}
+//	Cbrt({NaN, e}) = {NaN, _}
+func Cbrt(v Uncertain) (result Uncertain) {
+	if v.Value == 0 {
+		result.Value = 0
+		result.Error = math.Cbrt(v.Error)
+		return
+	}
+	result.Value = math.Cbrt(v.Value)
+	result.Error = v.Error / (3 * result.Value * result.Value)
+	return
+}
+
+// Pow returns x**y, the base-x exponential of y, and propagates errors of both the base and the exponent.
+// Absolute error is a sum of absolute errors caused by each argument.
+//
+// Special cases for values are the same as the special cases for math.Pow.
+// Special cases for errors are:
+//
+//	Pow({0, ex}, {y>0, ey}) = {0, max(ex**(y-ey), ex**(y+ey))} - derivative may be infinite at zero,
+//		so error is calculated from the intervals of both the base and the exponent.
+//	Pow({0, ex}, {y>0, ey}) = {0, max(1, ex**(y+ey))} if y-ey = 0 - the interval includes 0**0 = 1.
+//	Pow({0, ex}, {y>0, ey}) = {0, Inf} if y-ey < 0 - the interval includes negative exponents.
+//	Pow({0, ex}, {0, 0}) = {1, 0} - x**0 is 1 for any x.
+//	Pow({0, ex}, {0, ey}) = {1, Inf} if ey != 0 - 0**y is 0 for y > 0 and Inf for y < 0.
+//	Pow({0, ex}, {y<0, ey}) = {±Inf, Inf} if ex != 0 or ey != 0 - like division by zero.
+//	Pow({x<0, ex}, {y, ey}) = {x**y, NaN} if ey != 0 - negative base can't have uncertain exponent.
+func Pow(x, y Uncertain) (result Uncertain) {
+	result.Value = math.Pow(x.Value, y.Value)
+
+	if x.Value == 0 {
+		switch {
+		case y.Value > 0:
+			lo, hi := y.Value-y.Error, y.Value+y.Error
+			switch {
+			case lo > 0:
+				result.Error = math.Max(math.Pow(x.Error, lo), math.Pow(x.Error, hi))
+			case lo == 0:
+				result.Error = math.Max(1, math.Pow(x.Error, hi))
+			default:
+				result.Error = math.Inf(1)
+			}
+			return
+		case y.Value == 0 && y.Error != 0, y.Value < 0 && (x.Error != 0 || y.Error != 0):
+			result.Error = math.Inf(1)
+			return
+		case y.Value <= 0:
+			result.Error = 0
+			return
+		}
+	}
+
+	xErr, yErr := powErrors(x, y, result.Value)
+	result.Error = xErr + yErr
+	return
+}
+
+// powErrors returns contributions of the base and the exponent to the error of Pow.
+func powErrors(x, y Uncertain, value float64) (xErr, yErr float64) {
+	if x.Error != 0 {
+		xErr = math.Abs(y.Value * math.Pow(x.Value, y.Value-1) * x.Error)
+	}
+	if y.Error != 0 {
+		yErr = math.Abs(value * math.Log(x.Value) * y.Error)
+	}
+	return
+}
 
-// TODO
-//func Pow(x, y float64) float64
+// PowFloat returns x**y for an exact exponent y and propagates error of the base.
+//
+// Special cases are the same as for Pow.
+func PowFloat(x Uncertain, y float64) (result Uncertain) {
+	return Pow(x, Uncertain{y, 0})
+}
 
-// TODO
-//func Pow10(n int) float64
+// Pow10 returns 10**n, the base-10 exponential of n, as an exact value.
+//
+// Special cases are the same as for math.Pow10.
+func Pow10(n int) (result Uncertain) {
+	result.Value = math.Pow10(n)
+	result.Error = 0
+	return
+}
 
+// Sqrt returns the square root of v.Value and propagates error.
+//
+// Special case is:
+//
+//	Sqrt({0, e}) = {0, sqrt(e)} - derivative is infinite at zero, so error is calculated from the interval.
 func Sqrt(v Uncertain) (result Uncertain) {
 	if v.Value == 0 {
 		result.Value = 0
@@ -147,14 +147,129 @@ func TestDiv2(t *testing.T) {
 	}
 }
 
-// TODO
-//func TestCbrt(t *testing.T)
+func TestCbrt(t *testing.T) {
+	cases := [][2]Uncertain{
+		{{math.NaN(), 0.1}, {math.NaN(), 0}},
+		{{math.Inf(1), 0.1}, {math.Inf(1), 0}},
+		{{math.Inf(-1), 0.1}, {math.Inf(-1), 0}},
+		{{0, 0}, {0, 0}},
+		{{0, 0.125}, {0, 0.5}},
+		{{0, 0.001}, {0, 0.1}},
+		{{1, 0}, {1, 0}},
+		{{1, 0.3}, {1, 0.1}},
+		{{8, 0.6}, {2, 0.05}},
+		{{-8, 0.6}, {-2, 0.05}},
+		{{27, 2.7}, {3, 0.1}},
+		{{0.001, 0.0003}, {0.1, 0.01}},
+		{{1000, 30}, {10, 0.1}},
+	}
+
+	for i, the_case := range cases {
+		res := Cbrt(the_case[0])
+
+		if math.IsNaN(res.Value) {
+			if !math.IsNaN(the_case[1].Value) {
+				t.Fatalf("Test case %d failed: Cbrt(%f±%f) is %f±%f, got %f±%f",
+					i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+			} else {
+				continue
+			}
+		}
+
+		if !almostEqual(res, the_case[1]) {
+			t.Fatalf("Test case %d failed: Cbrt(%f±%f) is %f±%f, got %f±%f",
+				i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+		}
+	}
+}
+
+func TestPow(t *testing.T) {
+	cases := [][3]Uncertain{
+		{{0, 0}, {2, 0}, {0, 0}},
+		{{0, 0.01}, {2, 0}, {0, 0.0001}},
+		{{0, 0.01}, {0.5, 0.1}, {0, 0.158489319246111}},
+		{{0, 2}, {0.5, 0.1}, {0, 1.5157165665104}},
+		{{0, 0.01}, {0.5, 0.5}, {0, 1}},
+		{{0, 0.01}, {0.5, 0.6}, {0, math.Inf(1)}},
+		{{0, 0}, {0.5, 0.6}, {0, math.Inf(1)}},
+		{{0, 0}, {0.5, 0.5}, {0, 1}},
+		{{5, 0.1}, {0, 0}, {1, 0}},
+		{{-2, 0.1}, {2, 0.1}, {4, math.NaN()}},
+		{{0, 0.1}, {0, 0}, {1, 0}},
+		{{0, 0.1}, {0, 0.1}, {1, math.Inf(1)}},
+		{{0, 0.1}, {-1, 0}, {math.Inf(1), math.Inf(1)}},
+		{{0, 0}, {-2, 0.1}, {math.Inf(1), math.Inf(1)}},
+		{{0, 0}, {-2, 0}, {math.Inf(1), 0}},
+
+		{{2, 0.1}, {3, 0}, {8, 1.2}},
+		{{2, 0}, {3, 0.1}, {8, 0.554517744447956}},
+		{{2, 0.1}, {3, 0.1}, {8, 1.75451774444796}},
+		{{10, 1}, {2, 0.05}, {100, 31.5129254649702}},
+		{{0.5, 0.05}, {-2, 0.1}, {4, 1.07725887222398}},
+		{{9, 0.9}, {0.5, 0}, {3, 0.15}},
+		{{math.E, 0.1}, {2, 0.2}, {7.38905609893065, 2.02146758547794}},
+		{{-2, 0.1}, {3, 0}, {-8, 1.2}},
+	}
+
+	for i, the_case := range cases {
+		res := Pow(the_case[0], the_case[1])
+
+		if math.IsNaN(res.Error) {
+			if !math.IsNaN(the_case[2].Error) || res.Value != the_case[2].Value {
+				t.Fatalf("Test case %d failed: Pow(%f±%f, %f±%f) is %f±%f, got %f±%f",
+					i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, the_case[2].Value, the_case[2].Error, res.Value, res.Error)
+			} else {
+				continue
+			}
+		}
 
-// TODO
-//func Log2(t *testing.T) {
+		if !almostEqual(res, the_case[2]) {
+			t.Fatalf("Test case %d failed: Pow(%f±%f, %f±%f) is %f±%f, got %f±%f",
+				i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, the_case[2].Value, the_case[2].Error, res.Value, res.Error)
+		}
+	}
+}
 
-// TODO
-//func Pow(t *testing.T) {
+func TestPowFloat(t *testing.T) {
+	cases := []struct {
+		x   Uncertain
+		y   float64
+		res Uncertain
+	}{
+		{Uncertain{2, 0.1}, 3, Uncertain{8, 1.2}},
+		{Uncertain{9, 0.9}, 0.5, Uncertain{3, 0.15}},
+		{Uncertain{-2, 0.1}, 3, Uncertain{-8, 1.2}},
+		{Uncertain{4, 0.4}, -1, Uncertain{0.25, 0.025}},
+	}
+
+	for i, the_case := range cases {
+		res := PowFloat(the_case.x, the_case.y)
+
+		if !almostEqual(res, the_case.res) {
+			t.Fatalf("Test case %d failed: PowFloat(%f±%f, %f) is %f±%f, got %f±%f",
+				i, the_case.x.Value, the_case.x.Error, the_case.y, the_case.res.Value, the_case.res.Error, res.Value, res.Error)
+		}
+	}
+}
+
+func TestPow10(t *testing.T) {
+	cases := map[int]Uncertain{
+		-3:  {0.001, 0},
+		0:   {1, 0},
+		2:   {100, 0},
+		9:   {1e9, 0},
+		400: {math.Inf(1), 0},
+	}
+
+	for n, expected := range cases {
+		res := Pow10(n)
+
+		if !almostEqual(res, expected) {
+			t.Fatalf("Test case %d failed: Pow10(%d) is %f±%f, got %f±%f",
+				n, n, expected.Value, expected.Error, res.Value, res.Error)
+		}
+	}
+}
 
 func TestSqrt(t *testing.T) {
 	cases := [][2]Uncertain{
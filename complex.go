@@ -0,0 +1,189 @@
+package uncertain
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// UncertainComplex is a complex value with errors of its real and imaginary parts.
+//
+// Errors are standard deviations, and Cov is the covariance matrix of real and imaginary parts,
+// so that correlation between them, e.g. after multiplication, is preserved.
+// Errors are propagated to first order as J·Cov·Jᵀ, where J is the Jacobian of a function.
+// Operands of binary operations are considered independent.
+type UncertainComplex struct {
+	Value complex128
+	Cov   [2][2]float64
+}
+
+// NewComplex returns a complex value with independent real and imaginary parts.
+func NewComplex(re, im Uncertain) UncertainComplex {
+	return UncertainComplex{complex(re.Value, im.Value), [2][2]float64{{re.Error * re.Error, 0}, {0, im.Error * im.Error}}}
+}
+
+// NewComplexPolar returns a complex value r·e^(iθ) with independent magnitude and phase.
+func NewComplexPolar(r, theta Uncertain) UncertainComplex {
+	s, c := math.Sincos(theta.Value)
+	j := [2][2]float64{{c, -r.Value * s}, {s, r.Value * c}}
+	return UncertainComplex{complex(r.Value*c, r.Value*s), transform(j, [2][2]float64{{r.Error * r.Error, 0}, {0, theta.Error * theta.Error}})}
+}
+
+// Real returns the real part of c.
+func (c UncertainComplex) Real() Uncertain {
+	return Uncertain{real(c.Value), math.Sqrt(c.Cov[0][0])}
+}
+
+// Imag returns the imaginary part of c.
+func (c UncertainComplex) Imag() Uncertain {
+	return Uncertain{imag(c.Value), math.Sqrt(c.Cov[1][1])}
+}
+
+// Correlation returns the correlation coefficient of real and imaginary parts of c, 0 if any of them is exact.
+func (c UncertainComplex) Correlation() float64 {
+	if c.Cov[0][0] == 0 || c.Cov[1][1] == 0 {
+		return 0
+	}
+	return c.Cov[0][1] / math.Sqrt(c.Cov[0][0]*c.Cov[1][1])
+}
+
+// transform returns the covariance matrix j·cov·jᵀ, zero if cov is zero.
+func transform(j, cov [2][2]float64) (res [2][2]float64) {
+	if cov == [2][2]float64{} {
+		return
+	}
+	for r := range 2 {
+		for c := range 2 {
+			for k := range 2 {
+				for l := range 2 {
+					res[r][c] += j[r][k] * cov[k][l] * j[c][l]
+				}
+			}
+		}
+	}
+	return
+}
+
+// gradient returns the variance g·cov·gᵀ of a real function with gradient g, zero if cov is zero.
+func gradient(g [2]float64, cov [2][2]float64) float64 {
+	if cov == [2][2]float64{} {
+		return 0
+	}
+	return g[0]*g[0]*cov[0][0] + 2*g[0]*g[1]*cov[0][1] + g[1]*g[1]*cov[1][1]
+}
+
+// holomorphic returns the Jacobian of a holomorphic function with derivative d.
+func holomorphic(d complex128) [2][2]float64 {
+	return [2][2]float64{{real(d), -imag(d)}, {imag(d), real(d)}}
+}
+
+// addCov returns the sum of covariance matrices.
+func addCov(cov1, cov2 [2][2]float64) (res [2][2]float64) {
+	for r := range 2 {
+		for c := range 2 {
+			res[r][c] = cov1[r][c] + cov2[r][c]
+		}
+	}
+	return
+}
+
+// chain returns the result of a holomorphic function with the given value and derivative d at c.Value.
+func (c UncertainComplex) chain(value, d complex128) UncertainComplex {
+	return UncertainComplex{value, transform(holomorphic(d), c.Cov)}
+}
+
+// complexLinear returns the result of a holomorphic function of c1 and c2 with the given value and partial derivatives d1 and d2.
+func complexLinear(value complex128, c1 UncertainComplex, d1 complex128, c2 UncertainComplex, d2 complex128) UncertainComplex {
+	return UncertainComplex{value, addCov(transform(holomorphic(d1), c1.Cov), transform(holomorphic(d2), c2.Cov))}
+}
+
+// Add returns c1 + c2.
+func (c1 UncertainComplex) Add(c2 UncertainComplex) UncertainComplex {
+	return complexLinear(c1.Value+c2.Value, c1, 1, c2, 1)
+}
+
+// Sub returns c1 - c2.
+func (c1 UncertainComplex) Sub(c2 UncertainComplex) UncertainComplex {
+	return complexLinear(c1.Value-c2.Value, c1, 1, c2, -1)
+}
+
+// Mul returns c1 * c2.
+func (c1 UncertainComplex) Mul(c2 UncertainComplex) UncertainComplex {
+	return complexLinear(c1.Value*c2.Value, c1, c2.Value, c2, c1.Value)
+}
+
+// Div returns c1 / c2.
+func (c1 UncertainComplex) Div(c2 UncertainComplex) UncertainComplex {
+	q := c1.Value / c2.Value
+	return complexLinear(q, c1, 1/c2.Value, c2, -q/c2.Value)
+}
+
+// Conj returns the complex conjugate of c.
+func (c UncertainComplex) Conj() UncertainComplex {
+	return UncertainComplex{cmplx.Conj(c.Value), [2][2]float64{{c.Cov[0][0], -c.Cov[0][1]}, {-c.Cov[1][0], c.Cov[1][1]}}}
+}
+
+// Abs returns the magnitude of c.
+//
+// The magnitude of zero has no derivatives, its error is the largest possible magnitude,
+// the square root of the trace of the covariance, as for VecN.Norm.
+func (c UncertainComplex) Abs() Uncertain {
+	r := cmplx.Abs(c.Value)
+	if r == 0 {
+		return Uncertain{0, math.Sqrt(c.Cov[0][0] + c.Cov[1][1])}
+	}
+	return Uncertain{r, math.Sqrt(gradient([2]float64{real(c.Value) / r, imag(c.Value) / r}, c.Cov))}
+}
+
+// Phase returns the phase of c in the range [-π, π].
+// For independent real and imaginary parts it is the same as Quadrature{}.Atan2(c.Imag(), c.Real()).
+//
+// The phase of uncertain zero may be any angle, so its error is π; the phase of exact zero is exact.
+func (c UncertainComplex) Phase() Uncertain {
+	x, y := real(c.Value), imag(c.Value)
+	r2 := x*x + y*y
+	if r2 == 0 {
+		return Uncertain{math.Atan2(y, x), math.Sqrt(c.phaseVariance())}
+	}
+	return Uncertain{math.Atan2(y, x), math.Sqrt(gradient([2]float64{-y / r2, x / r2}, c.Cov))}
+}
+
+// phaseVariance returns the variance of the phase of zero c: π² if c is uncertain, otherwise 0.
+func (c UncertainComplex) phaseVariance() float64 {
+	if c.Cov == [2][2]float64{} {
+		return 0
+	}
+	return math.Pi * math.Pi
+}
+
+// Polar returns the magnitude and the phase of c. They may be correlated, see PolarCov.
+func (c UncertainComplex) Polar() (r, theta Uncertain) {
+	return c.Abs(), c.Phase()
+}
+
+// PolarCov returns the covariance matrix of the magnitude and the phase of c.
+// For zero c the magnitude and the phase are uncorrelated, with variances as in Abs and Phase.
+func (c UncertainComplex) PolarCov() [2][2]float64 {
+	x, y := real(c.Value), imag(c.Value)
+	r := math.Hypot(x, y)
+	if r == 0 {
+		return [2][2]float64{{c.Cov[0][0] + c.Cov[1][1], 0}, {0, c.phaseVariance()}}
+	}
+	return transform([2][2]float64{{x / r, y / r}, {-y / (r * r), x / (r * r)}}, c.Cov)
+}
+
+// Exp returns e**c.
+func (c UncertainComplex) Exp() UncertainComplex {
+	e := cmplx.Exp(c.Value)
+	return c.chain(e, e)
+}
+
+// Log returns the natural logarithm of c.
+func (c UncertainComplex) Log() UncertainComplex {
+	return c.chain(cmplx.Log(c.Value), 1/c.Value)
+}
+
+// Sqrt returns the square root of c.
+func (c UncertainComplex) Sqrt() UncertainComplex {
+	s := cmplx.Sqrt(c.Value)
+	return c.chain(s, 1/(2*s))
+}
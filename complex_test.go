@@ -0,0 +1,118 @@
+package uncertain
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// equalCov reports whether covariance matrices are equal with relative tolerance.
+func equalCov(cov1, cov2 [2][2]float64) bool {
+	for r := range 2 {
+		for c := range 2 {
+			if math.Abs(cov1[r][c]-cov2[r][c]) > 1e-9*math.Max(1, math.Abs(cov2[r][c])) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestComplexArithmetics(t *testing.T) {
+	z1 := NewComplex(Uncertain{1, 0.1}, Uncertain{2, 0.2})
+	z2 := NewComplex(Uncertain{3, 0.3}, Uncertain{-1, 0.1})
+	i := NewComplex(Uncertain{0, 0}, Uncertain{1, 0})
+
+	cases := []struct {
+		name  string
+		res   UncertainComplex
+		value complex128
+		cov   [2][2]float64
+	}{
+		{"z1+z2", z1.Add(z2), 4 + 1i, [2][2]float64{{0.1, 0}, {0, 0.05}}},
+		{"z1-z2", z1.Sub(z2), -2 + 3i, [2][2]float64{{0.1, 0}, {0, 0.05}}},
+		{"z1*i", z1.Mul(i), -2 + 1i, [2][2]float64{{0.04, 0}, {0, 0.01}}},
+		{"z1/i", z1.Div(i), 2 - 1i, [2][2]float64{{0.04, 0}, {0, 0.01}}},
+		{"conj(z1*(1+i))", z1.Mul(NewComplex(Uncertain{1, 0}, Uncertain{1, 0})).Conj(), -1 - 3i, [2][2]float64{{0.05, 0.03}, {0.03, 0.05}}},
+		{"z1*z2", z1.Mul(z2), 5 + 5i, [2][2]float64{{0.26, 0.25}, {0.25, 0.74}}},
+	}
+
+	for n, the_case := range cases {
+		if cmplx.Abs(the_case.res.Value-the_case.value) > 1e-12 || !equalCov(the_case.res.Cov, the_case.cov) {
+			t.Fatalf("Test case %d failed: %s is %v with covariance %v, got %v with covariance %v",
+				n, the_case.name, the_case.value, the_case.cov, the_case.res.Value, the_case.res.Cov)
+		}
+	}
+
+	if re, im := z1.Real(), z1.Imag(); re != (Uncertain{1, 0.1}) || !almostEqual(im, Uncertain{2, 0.2}) || z1.Correlation() != 0 {
+		t.Fatalf("Parts of 1±0.1 + i·2±0.2 are wrong: %v, %v, correlation %f", re, im, z1.Correlation())
+	}
+	if rho := z1.Mul(NewComplex(Uncertain{1, 0}, Uncertain{1, 0})).Correlation(); math.Abs(rho+0.6) > 1e-12 {
+		t.Fatalf("Correlation of parts of (1±0.1 + i·2±0.2)(1+i) is -0.6, got %f", rho)
+	}
+}
+
+func TestComplexMatchesMonteCarlo(t *testing.T) {
+	inputs := []Uncertain{{1, 0.01}, {2, 0.02}, {3, 0.03}, {-1, 0.01}}
+	z1, z2 := NewComplex(inputs[0], inputs[1]), NewComplex(inputs[2], inputs[3])
+
+	cases := []struct {
+		name string
+		res  UncertainComplex
+		f    func(z1, z2 complex128) complex128
+	}{
+		{"z1*z2", z1.Mul(z2), func(z1, z2 complex128) complex128 { return z1 * z2 }},
+		{"z1/z2", z1.Div(z2), func(z1, z2 complex128) complex128 { return z1 / z2 }},
+		{"exp(z1)", z1.Exp(), func(z1, z2 complex128) complex128 { return cmplx.Exp(z1) }},
+		{"log(z1)", z1.Log(), func(z1, z2 complex128) complex128 { return cmplx.Log(z1) }},
+		{"sqrt(z2)", z2.Sqrt(), func(z1, z2 complex128) complex128 { return cmplx.Sqrt(z2) }},
+	}
+
+	mc := MonteCarlo{Seed: 1}
+
+	for n, the_case := range cases {
+		f := the_case.f
+		z := func(x []float64) complex128 { return f(complex(x[0], x[1]), complex(x[2], x[3])) }
+		re := mc.Run(func(x []float64) float64 { return real(z(x)) }, inputs).Uncertain()
+		im := mc.Run(func(x []float64) float64 { return imag(z(x)) }, inputs).Uncertain()
+
+		if math.Abs(the_case.res.Real().Error/re.Error-1) > 0.02 || math.Abs(the_case.res.Imag().Error/im.Error-1) > 0.02 {
+			t.Fatalf("Test case %d failed: %s is %v + i·%v by Monte Carlo, got %v + i·%v",
+				n, the_case.name, re, im, the_case.res.Real(), the_case.res.Imag())
+		}
+	}
+}
+
+func TestComplexPolar(t *testing.T) {
+	z := NewComplex(Uncertain{3, 0.3}, Uncertain{4, 0.2})
+
+	r, theta := z.Polar()
+	if !almostEqual(r, Uncertain{5, math.Hypot(3*0.3, 4*0.2) / 5}) {
+		t.Fatalf("Magnitude of 3±0.3 + i·4±0.2 is 5±0.24, got %v", r)
+	}
+	if phase := (Quadrature{}).Atan2(z.Imag(), z.Real()); !almostEqual(theta, phase) {
+		t.Fatalf("Phase of 3±0.3 + i·4±0.2 is %v, got %v", phase, theta)
+	}
+
+	p := NewComplexPolar(Uncertain{2, 0.1}, Uncertain{math.Pi / 3, 0.05})
+	r, theta = p.Polar()
+	if !almostEqual(r, Uncertain{2, 0.1}) || !almostEqual(theta, Uncertain{math.Pi / 3, 0.05}) || !equalCov(p.PolarCov(), [2][2]float64{{0.01, 0}, {0, 0.0025}}) {
+		t.Fatalf("Polar form of 2±0.1 at π/3±0.05 is wrong: %v, %v, covariance %v", r, theta, p.PolarCov())
+	}
+	if re := p.Real(); !almostEqual(re, Uncertain{1, math.Hypot(0.1*0.5, 2*0.05*math.Sin(math.Pi/3))}) {
+		t.Fatalf("Real part of 2±0.1 at π/3±0.05 is wrong: %v", re)
+	}
+
+	exact := NewComplex(Uncertain{0, 0}, Uncertain{0, 0})
+	if r, theta := exact.Polar(); r != (Uncertain{0, 0}) || theta != (Uncertain{0, 0}) || exact.PolarCov() != ([2][2]float64{}) {
+		t.Fatalf("Polar form of exact zero is 0±0 at 0±0, got %v at %v", r, theta)
+	}
+
+	zero := NewComplex(Uncertain{0, 0.3}, Uncertain{0, 0.4})
+	if r, theta := zero.Polar(); r != (Uncertain{0, 0.5}) || theta != (Uncertain{0, math.Pi}) {
+		t.Fatalf("Polar form of uncertain zero is 0±0.5 at 0±π, got %v at %v", r, theta)
+	}
+	if cov := zero.PolarCov(); !equalCov(cov, [2][2]float64{{0.25, 0}, {0, math.Pi * math.Pi}}) {
+		t.Fatalf("Polar covariance of uncertain zero is wrong: %v", cov)
+	}
+}
@@ -0,0 +1,234 @@
+package uncertain
+
+import "math"
+
+// Dual is a dual number for forward-mode automatic differentiation:
+// a value together with its partial derivatives with respect to the arguments of a formula.
+//
+// A Dual with nil Grad, e.g. Dual{Value: 2}, is a constant.
+// Duals are usually created by Eval, which passes the arguments of a formula as Duals,
+// so that any formula written with methods of Dual propagates errors without hand-written derivatives:
+//
+//	// Kinetic energy m·v²/2
+//	e := Eval(func(x ...Dual) Dual {
+//		m, v := x[0], x[1]
+//		return m.Mul(v).Mul(v).Div(Dual{Value: 2})
+//	}, Uncertain{2, 0.1}, Uncertain{3, 0.2})
+type Dual struct {
+	Value float64
+	Grad  []float64
+}
+
+// Eval evaluates formula f at values of args and propagates their errors.
+// Partial derivatives are calculated automatically, errors are combined by Linear propagator.
+func Eval(f func(x ...Dual) Dual, args ...Uncertain) Uncertain {
+	return EvalWith(Linear{}, f, args...)
+}
+
+// EvalWith evaluates formula f at values of args and propagates their errors.
+// Partial derivatives are calculated automatically, errors are combined by propagator p.
+func EvalWith(p Propagator, f func(x ...Dual) Dual, args ...Uncertain) Uncertain {
+	x := make([]Dual, len(args))
+	for i, arg := range args {
+		x[i].Value = arg.Value
+		x[i].Grad = make([]float64, len(args))
+		x[i].Grad[i] = 1
+	}
+
+	res := f(x...)
+
+	contributions := make([]float64, 0, len(args))
+	for i, arg := range args {
+		if arg.Error != 0 && i < len(res.Grad) {
+			contributions = append(contributions, res.Grad[i]*arg.Error)
+		}
+	}
+	return Uncertain{res.Value, p.Combine(contributions...)}
+}
+
+// chain returns a Dual with the given value which depends on d with derivative k.
+func (d Dual) chain(value, k float64) Dual {
+	return dualLinear(value, d, k, Dual{}, 0)
+}
+
+// dualLinear returns a Dual with the given value which depends on d1 and d2 with derivatives k1 and k2.
+// Zero components of gradients stay zero even if a derivative is infinite.
+func dualLinear(value float64, d1 Dual, k1 float64, d2 Dual, k2 float64) Dual {
+	grad := make([]float64, max(len(d1.Grad), len(d2.Grad)))
+
+	for i, g := range d1.Grad {
+		if g != 0 {
+			grad[i] += k1 * g
+		}
+	}
+	for i, g := range d2.Grad {
+		if g != 0 {
+			grad[i] += k2 * g
+		}
+	}
+	return Dual{value, grad}
+}
+
+// Add returns d1 + d2.
+func (d1 Dual) Add(d2 Dual) Dual {
+	return dualLinear(d1.Value+d2.Value, d1, 1, d2, 1)
+}
+
+// Sub returns d1 - d2.
+func (d1 Dual) Sub(d2 Dual) Dual {
+	return dualLinear(d1.Value-d2.Value, d1, 1, d2, -1)
+}
+
+// Mul returns d1 * d2.
+func (d1 Dual) Mul(d2 Dual) Dual {
+	return dualLinear(d1.Value*d2.Value, d1, d2.Value, d2, d1.Value)
+}
+
+// Div returns d1 / d2.
+func (d1 Dual) Div(d2 Dual) Dual {
+	q := d1.Value / d2.Value
+	return dualLinear(q, d1, 1/d2.Value, d2, -q/d2.Value)
+}
+
+// Neg returns -d.
+func (d Dual) Neg() Dual {
+	return d.chain(-d.Value, -1)
+}
+
+// Abs returns the absolute value of d.
+func (d Dual) Abs() Dual {
+	if d.Value < 0 {
+		return d.Neg()
+	}
+	return d
+}
+
+// Pow returns x**y.
+func (x Dual) Pow(y Dual) Dual {
+	p := math.Pow(x.Value, y.Value)
+	return dualLinear(p, x, y.Value*math.Pow(x.Value, y.Value-1), y, p*math.Log(x.Value))
+}
+
+// PowFloat returns d**y for a constant exponent y.
+func (d Dual) PowFloat(y float64) Dual {
+	return d.chain(math.Pow(d.Value, y), y*math.Pow(d.Value, y-1))
+}
+
+// Sqrt returns the square root of d.
+func (d Dual) Sqrt() Dual {
+	s := math.Sqrt(d.Value)
+	return d.chain(s, 1/(2*s))
+}
+
+// Cbrt returns the cube root of d.
+func (d Dual) Cbrt() Dual {
+	c := math.Cbrt(d.Value)
+	return d.chain(c, 1/(3*c*c))
+}
+
+// Exp returns e**d.
+func (d Dual) Exp() Dual {
+	e := math.Exp(d.Value)
+	return d.chain(e, e)
+}
+
+// Exp2 returns 2**d.
+func (d Dual) Exp2() Dual {
+	e := math.Exp2(d.Value)
+	return d.chain(e, e*math.Ln2)
+}
+
+// Expm1 returns e**d - 1.
+func (d Dual) Expm1() Dual {
+	return d.chain(math.Expm1(d.Value), math.Exp(d.Value))
+}
+
+// Log returns the natural logarithm of d.
+func (d Dual) Log() Dual {
+	return d.chain(math.Log(d.Value), 1/d.Value)
+}
+
+// Log10 returns the decimal logarithm of d.
+func (d Dual) Log10() Dual {
+	return d.chain(math.Log10(d.Value), 1/(d.Value*math.Ln10))
+}
+
+// Log2 returns the binary logarithm of d.
+func (d Dual) Log2() Dual {
+	return d.chain(math.Log2(d.Value), 1/(d.Value*math.Ln2))
+}
+
+// Log1p returns the natural logarithm of 1 plus d.
+func (d Dual) Log1p() Dual {
+	return d.chain(math.Log1p(d.Value), 1/(1+d.Value))
+}
+
+// Cos returns the cosine of the radian argument d.
+func (d Dual) Cos() Dual {
+	s, c := math.Sincos(d.Value)
+	return d.chain(c, -s)
+}
+
+// Sin returns the sine of the radian argument d.
+func (d Dual) Sin() Dual {
+	s, c := math.Sincos(d.Value)
+	return d.chain(s, c)
+}
+
+// Tan returns the tangent of the radian argument d.
+func (d Dual) Tan() Dual {
+	t := math.Tan(d.Value)
+	return d.chain(t, 1+t*t)
+}
+
+// Acos returns the arccosine, in radians, of d.
+func (d Dual) Acos() Dual {
+	return d.chain(math.Acos(d.Value), -1/math.Sqrt(1-d.Value*d.Value))
+}
+
+// Asin returns the arcsine, in radians, of d.
+func (d Dual) Asin() Dual {
+	return d.chain(math.Asin(d.Value), 1/math.Sqrt(1-d.Value*d.Value))
+}
+
+// Atan returns the arctangent, in radians, of d.
+func (d Dual) Atan() Dual {
+	return d.chain(math.Atan(d.Value), 1/(1+d.Value*d.Value))
+}
+
+// Atan2 returns the arc tangent of y/x, using the signs of the two to determine the quadrant of the return value.
+func (y Dual) Atan2(x Dual) Dual {
+	r2 := x.Value*x.Value + y.Value*y.Value
+	return dualLinear(math.Atan2(y.Value, x.Value), y, x.Value/r2, x, -y.Value/r2)
+}
+
+// Cosh returns the hyperbolic cosine of d.
+func (d Dual) Cosh() Dual {
+	return d.chain(math.Cosh(d.Value), math.Sinh(d.Value))
+}
+
+// Sinh returns the hyperbolic sine of d.
+func (d Dual) Sinh() Dual {
+	return d.chain(math.Sinh(d.Value), math.Cosh(d.Value))
+}
+
+// Tanh returns the hyperbolic tangent of d.
+func (d Dual) Tanh() Dual {
+	t := math.Tanh(d.Value)
+	return d.chain(t, 1-t*t)
+}
+
+// Acosh returns the inverse hyperbolic cosine of d.
+func (d Dual) Acosh() Dual {
+	return d.chain(math.Acosh(d.Value), 1/math.Sqrt(d.Value*d.Value-1))
+}
+
+// Asinh returns the inverse hyperbolic sine of d.
+func (d Dual) Asinh() Dual {
+	return d.chain(math.Asinh(d.Value), 1/math.Sqrt(1+d.Value*d.Value))
+}
+
+// Atanh returns the inverse hyperbolic tangent of d.
+func (d Dual) Atanh() Dual {
+	return d.chain(math.Atanh(d.Value), 1/(1-d.Value*d.Value))
+}
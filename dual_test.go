@@ -0,0 +1,117 @@
+package uncertain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDualDerivatives(t *testing.T) {
+	cases := []struct {
+		name string
+		d    func(Dual) Dual
+		f    func(float64) float64
+		x    float64
+	}{
+		{"Neg", Dual.Neg, func(x float64) float64 { return -x }, 0.3},
+		{"Abs", Dual.Abs, math.Abs, -0.3},
+		{"PowFloat", func(d Dual) Dual { return d.PowFloat(2.5) }, func(x float64) float64 { return math.Pow(x, 2.5) }, 1.7},
+		{"Sqrt", Dual.Sqrt, math.Sqrt, 2},
+		{"Cbrt", Dual.Cbrt, math.Cbrt, -2},
+		{"Exp", Dual.Exp, math.Exp, 0.7},
+		{"Exp2", Dual.Exp2, math.Exp2, 0.7},
+		{"Expm1", Dual.Expm1, math.Expm1, 0.7},
+		{"Log", Dual.Log, math.Log, 3},
+		{"Log10", Dual.Log10, math.Log10, 3},
+		{"Log2", Dual.Log2, math.Log2, 3},
+		{"Log1p", Dual.Log1p, math.Log1p, 3},
+		{"Cos", Dual.Cos, math.Cos, 1},
+		{"Sin", Dual.Sin, math.Sin, 1},
+		{"Tan", Dual.Tan, math.Tan, 1},
+		{"Acos", Dual.Acos, math.Acos, 0.4},
+		{"Asin", Dual.Asin, math.Asin, 0.4},
+		{"Atan", Dual.Atan, math.Atan, 0.4},
+		{"Cosh", Dual.Cosh, math.Cosh, 0.8},
+		{"Sinh", Dual.Sinh, math.Sinh, 0.8},
+		{"Tanh", Dual.Tanh, math.Tanh, 0.8},
+		{"Acosh", Dual.Acosh, math.Acosh, 1.8},
+		{"Asinh", Dual.Asinh, math.Asinh, 1.8},
+		{"Atanh", Dual.Atanh, math.Atanh, 0.8},
+	}
+
+	const h = 1e-6
+
+	for i, the_case := range cases {
+		res := the_case.d(Dual{the_case.x, []float64{1}})
+		numeric := (the_case.f(the_case.x+h) - the_case.f(the_case.x-h)) / (2 * h)
+
+		if res.Value != the_case.f(the_case.x) || math.Abs(res.Grad[0]-numeric) > 1e-6*math.Max(1, math.Abs(numeric)) {
+			t.Fatalf("Test case %d failed: %s at %f is %f with derivative %f, got %f with derivative %f",
+				i, the_case.name, the_case.x, the_case.f(the_case.x), numeric, res.Value, res.Grad[0])
+		}
+	}
+}
+
+func TestDualBinary(t *testing.T) {
+	x := Dual{3, []float64{1, 0}}
+	y := Dual{2, []float64{0, 1}}
+
+	cases := []struct {
+		name string
+		d    Dual
+		res  Dual
+	}{
+		{"x+y", x.Add(y), Dual{5, []float64{1, 1}}},
+		{"x-y", x.Sub(y), Dual{1, []float64{1, -1}}},
+		{"x*y", x.Mul(y), Dual{6, []float64{2, 3}}},
+		{"x/y", x.Div(y), Dual{1.5, []float64{0.5, -0.75}}},
+		{"x**y", x.Pow(y), Dual{9, []float64{6, 9 * math.Log(3)}}},
+		{"atan2(y, x)", y.Atan2(x), Dual{math.Atan2(2, 3), []float64{-2.0 / 13, 3.0 / 13}}},
+		{"x*2", x.Mul(Dual{Value: 2}), Dual{6, []float64{2, 0}}},
+		{"x-x", x.Sub(x), Dual{0, []float64{0, 0}}},
+	}
+
+	for i, the_case := range cases {
+		if math.Abs(the_case.d.Value-the_case.res.Value) > 1e-12 || len(the_case.d.Grad) != len(the_case.res.Grad) {
+			t.Fatalf("Test case %d failed: %s is %v, got %v", i, the_case.name, the_case.res, the_case.d)
+		}
+		for n := range the_case.res.Grad {
+			if math.Abs(the_case.d.Grad[n]-the_case.res.Grad[n]) > 1e-12 {
+				t.Fatalf("Test case %d failed: %s is %v, got %v", i, the_case.name, the_case.res, the_case.d)
+			}
+		}
+	}
+}
+
+func TestEval(t *testing.T) {
+	energy := func(x ...Dual) Dual {
+		m, v := x[0], x[1]
+		return m.Mul(v).Mul(v).Div(Dual{Value: 2})
+	}
+
+	cases := []struct {
+		name string
+		res  Uncertain
+		exp  Uncertain
+	}{
+		{"m*v*v/2", Eval(energy, Uncertain{2, 0.1}, Uncertain{3, 0.2}), Uncertain{9, 1.65}},
+		{"x-x", Eval(func(x ...Dual) Dual { return x[0].Sub(x[0]) }, Uncertain{10, 1}), Uncertain{0, 0}},
+		{"x*y", Eval(func(x ...Dual) Dual { return x[0].Mul(x[1]) }, Uncertain{10, 1}, Uncertain{5, 0.5}), Uncertain{10, 1}.Mul(Uncertain{5, 0.5})},
+		{"sin(x)", Eval(func(x ...Dual) Dual { return x[0].Sin() }, Uncertain{1, 0.05}), Sin(Uncertain{1, 0.05})},
+		{"exp(x)", Eval(func(x ...Dual) Dual { return x[0].Exp() }, Uncertain{1, 0.1}), Exp(Uncertain{1, 0.1})},
+		{"atanh(x)", Eval(func(x ...Dual) Dual { return x[0].Atanh() }, Uncertain{0.5, 0.1}), Atanh(Uncertain{0.5, 0.1})},
+		{"constant", Eval(func(x ...Dual) Dual { return Dual{Value: 42} }, Uncertain{1, 0.1}), Uncertain{42, 0}},
+		{"exact", Eval(func(x ...Dual) Dual { return x[0].Sqrt() }, Uncertain{0, 0}), Uncertain{0, 0}},
+	}
+
+	for i, the_case := range cases {
+		if !almostEqual(the_case.res, the_case.exp) {
+			t.Fatalf("Test case %d failed: %s is %f±%f, got %f±%f",
+				i, the_case.name, the_case.exp.Value, the_case.exp.Error, the_case.res.Value, the_case.res.Error)
+		}
+	}
+
+	res := EvalWith(Quadrature{}, func(x ...Dual) Dual { return x[0].Add(x[1]) }, Uncertain{10, 3}, Uncertain{-5, 4})
+	if !almostEqual(res, Uncertain{5, 5}) {
+		t.Fatalf("Quadrature sum of 10±3 and -5±4 is 5±5, got %f±%f", res.Value, res.Error)
+	}
+}
@@ -1,7 +1,77 @@
 package uncertain
 
-// TODO
-//func Exp(x float64) float64
+import "math"
 
-// TODO
-//func Exp2(x float64) float64
+// Exp returns e**v.Value, the base-e exponential of v.Value, and propagates error.
+//
+// Special cases are:
+//
+//	Exp({x, 0}) = {e**x, 0}
+//	Exp({+Inf, e}) = {+Inf, +Inf}
+//	Exp({-Inf, e}) = {0, 0}
+//	Exp({NaN, e}) = {NaN, _}
+//
+// Very large values overflow to {+Inf, +Inf}.
+func Exp(v Uncertain) (result Uncertain) {
+	result.Value = math.Exp(v.Value)
+
+	if v.Error == 0 {
+		result.Error = 0
+		return
+	}
+
+	result.Error = result.Value * v.Error
+	return
+}
+
+// Exp2 returns 2**v.Value, the base-2 exponential of v.Value, and propagates error.
+//
+// Special cases are the same as for Exp.
+func Exp2(v Uncertain) (result Uncertain) {
+	result.Value = math.Exp2(v.Value)
+
+	if v.Error == 0 {
+		result.Error = 0
+		return
+	}
+
+	result.Error = result.Value * math.Ln2 * v.Error
+	return
+}
+
+// Exp10 returns 10**v.Value, the base-10 exponential of v.Value, and propagates error.
+// Use Pow10 for exact integer powers.
+//
+// Special cases are the same as for Exp.
+func Exp10(v Uncertain) (result Uncertain) {
+	result.Value = math.Pow(10, v.Value)
+
+	if v.Error == 0 {
+		result.Error = 0
+		return
+	}
+
+	result.Error = result.Value * math.Ln10 * v.Error
+	return
+}
+
+// Expm1 returns e**v.Value - 1, the base-e exponential of v.Value minus 1, and propagates error.
+// It is more accurate than Exp(v) - 1 when v.Value is near zero.
+//
+// Special cases are:
+//
+//	Expm1({x, 0}) = {e**x - 1, 0}
+//	Expm1({+Inf, e}) = {+Inf, +Inf}
+//	Expm1({-Inf, e}) = {-1, 0}
+//	Expm1({NaN, e}) = {NaN, _}
+func Expm1(v Uncertain) (result Uncertain) {
+	result.Value = math.Expm1(v.Value)
+
+	if v.Error == 0 {
+		result.Error = 0
+		return
+	}
+
+	result.Error = math.Exp(v.Value) * v.Error
+	return
+}
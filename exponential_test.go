@@ -0,0 +1,153 @@
+package uncertain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestExp(t *testing.T) {
+	cases := [][2]Uncertain{
+		{{math.Inf(-1), 10}, {0, 0}},
+		{{math.Inf(1), 0}, {math.Inf(1), 0}},
+		{{math.Inf(1), 10}, {math.Inf(1), math.Inf(1)}},
+		{{math.NaN(), 10}, {math.NaN(), 0}},
+		{{1000, 0}, {math.Inf(1), 0}},
+		{{1000, 0.1}, {math.Inf(1), math.Inf(1)}},
+
+		{{0, 0}, {1, 0}},
+		{{0, 0.1}, {1, 0.1}},
+		{{1, 0}, {2.71828182845905, 0}},
+		{{1, 0.1}, {2.71828182845905, 0.271828182845905}},
+		{{-1, 0.1}, {0.367879441171442, 0.0367879441171442}},
+		{{2, 0.05}, {7.38905609893065, 0.369452804946533}},
+		{{-2, 0.5}, {0.135335283236613, 0.0676676416183064}},
+		{{0.5, 0.2}, {1.64872127070013, 0.329744254140026}},
+		{{-0.5, 0.2}, {0.606530659712633, 0.121306131942527}},
+		{{10, 0.01}, {22026.4657948067, 220.264657948067}},
+	}
+
+	for i, the_case := range cases {
+		res := Exp(the_case[0])
+
+		if math.IsNaN(res.Value) {
+			if !math.IsNaN(the_case[1].Value) {
+				t.Fatalf("Test case %d failed: Exp(%f±%f) is %f±%f, got %f±%f",
+					i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+			} else {
+				continue
+			}
+		}
+
+		if !almostEqual(res, the_case[1]) {
+			t.Fatalf("Test case %d failed: Exp(%f±%f) is %f±%f, got %f±%f",
+				i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+		}
+	}
+}
+
+func TestExp2(t *testing.T) {
+	cases := [][2]Uncertain{
+		{{math.Inf(-1), 10}, {0, 0}},
+		{{math.Inf(1), 10}, {math.Inf(1), math.Inf(1)}},
+		{{math.NaN(), 10}, {math.NaN(), 0}},
+
+		{{0, 0}, {1, 0}},
+		{{0, 0.1}, {1, 0.0693147180559945}},
+		{{1, 0}, {2, 0}},
+		{{1, 0.1}, {2, 0.138629436111989}},
+		{{-1, 0.1}, {0.5, 0.0346573590279973}},
+		{{2, 0.05}, {4, 0.138629436111989}},
+		{{-2, 0.5}, {0.25, 0.0866433975699932}},
+		{{0.5, 0.2}, {1.4142135623731, 0.196051628693709}},
+		{{-0.5, 0.2}, {0.707106781186548, 0.0980258143468547}},
+		{{10, 0.01}, {1024, 7.09782712893384}},
+	}
+
+	for i, the_case := range cases {
+		res := Exp2(the_case[0])
+
+		if math.IsNaN(res.Value) {
+			if !math.IsNaN(the_case[1].Value) {
+				t.Fatalf("Test case %d failed: Exp2(%f±%f) is %f±%f, got %f±%f",
+					i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+			} else {
+				continue
+			}
+		}
+
+		if !almostEqual(res, the_case[1]) {
+			t.Fatalf("Test case %d failed: Exp2(%f±%f) is %f±%f, got %f±%f",
+				i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+		}
+	}
+}
+
+func TestExp10(t *testing.T) {
+	cases := [][2]Uncertain{
+		{{math.Inf(-1), 10}, {0, 0}},
+		{{math.Inf(1), 10}, {math.Inf(1), math.Inf(1)}},
+		{{math.NaN(), 10}, {math.NaN(), 0}},
+
+		{{-3, 0}, {0.001, 0}},
+		{{0, 0.1}, {1, 0.230258509299405}},
+		{{2, 0.01}, {100, 2.30258509299405}},
+		{{0.5, 0.1}, {3.16227766016838, 0.72814134002118}},
+		{{400, 0}, {math.Inf(1), 0}},
+	}
+
+	for i, the_case := range cases {
+		res := Exp10(the_case[0])
+
+		if math.IsNaN(res.Value) {
+			if !math.IsNaN(the_case[1].Value) {
+				t.Fatalf("Test case %d failed: Exp10(%f±%f) is %f±%f, got %f±%f",
+					i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+			} else {
+				continue
+			}
+		}
+
+		if !almostEqual(res, the_case[1]) {
+			t.Fatalf("Test case %d failed: Exp10(%f±%f) is %f±%f, got %f±%f",
+				i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+		}
+	}
+}
+
+func TestExpm1(t *testing.T) {
+	cases := [][2]Uncertain{
+		{{math.Inf(-1), 10}, {-1, 0}},
+		{{math.Inf(1), 10}, {math.Inf(1), math.Inf(1)}},
+		{{math.NaN(), 10}, {math.NaN(), 0}},
+
+		{{0, 0}, {0, 0}},
+		{{0, 0.1}, {0, 0.1}},
+		{{1e-10, 1e-12}, {1.00000000005e-10, 1.0000000001e-12}},
+		{{1, 0}, {1.71828182845905, 0}},
+		{{1, 0.1}, {1.71828182845905, 0.271828182845905}},
+		{{-1, 0.1}, {-0.632120558828558, 0.0367879441171442}},
+		{{2, 0.05}, {6.38905609893065, 0.369452804946533}},
+		{{-2, 0.5}, {-0.864664716763387, 0.0676676416183064}},
+		{{0.5, 0.2}, {0.648721270700128, 0.329744254140026}},
+		{{-0.5, 0.2}, {-0.393469340287367, 0.121306131942527}},
+		{{10, 0.01}, {22025.4657948067, 220.264657948067}},
+	}
+
+	for i, the_case := range cases {
+		res := Expm1(the_case[0])
+
+		if math.IsNaN(res.Value) {
+			if !math.IsNaN(the_case[1].Value) {
+				t.Fatalf("Test case %d failed: Expm1(%f±%f) is %f±%f, got %f±%f",
+					i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+			} else {
+				continue
+			}
+		}
+
+		if !almostEqual(res, the_case[1]) {
+			t.Fatalf("Test case %d failed: Expm1(%f±%f) is %f±%f, got %f±%f",
+				i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package uncertain
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// lastDigit returns the power of ten of the last significant digit of err rounded to n significant digits.
+//
+// If n <= 0, the number of digits is chosen by the usual rule based on three highest digits of the error:
+//
+//	100-354 - two significant digits
+//	355-949 - one significant digit
+//	950-999 - the error is rounded up to 1000 and two significant digits are kept
+func lastDigit(err float64, n int) int {
+	exp := int(math.Floor(math.Log10(err)))
+
+	if n <= 0 {
+		three := math.Round(err / math.Pow10(exp-2))
+		if three >= 1000 {
+			three /= 10
+			exp++
+		}
+		switch {
+		case three <= 354:
+			n = 2
+		case three <= 949:
+			n = 1
+		default:
+			n = 2
+			exp++
+		}
+	}
+
+	pos := exp - n + 1
+	if math.Round(err/math.Pow10(pos)) >= math.Pow10(n) {
+		pos++
+	}
+	return pos
+}
+
+// roundTo returns x rounded to a multiple of 10**pos.
+func roundTo(x float64, pos int) float64 {
+	if pos < 0 {
+		return math.Round(x*math.Pow10(-pos)) / math.Pow10(-pos)
+	}
+	return math.Round(x/math.Pow10(pos)) * math.Pow10(pos)
+}
+
+// magnitude returns the decimal exponent of x, 0 for zero.
+func magnitude(x float64) int {
+	if x == 0 {
+		return 0
+	}
+	return int(math.Floor(math.Log10(math.Abs(x))))
+}
+
+// split returns v rounded to n significant digits of the error (see lastDigit) as strings
+// of the value and the error scaled by 10**-exp.
+//
+// The notation is 'f' for the fixed notation with exp = 0, 'e' for the scientific notation
+// with exp equal to the decimal exponent of the larger of the value and the error,
+// or 'g' to use the scientific notation only for large or small exponents.
+//
+// If the error is zero or any of v's fields is not finite, no rounding is done.
+func (v Uncertain) split(n int, notation byte) (value, err string, exp int) {
+	exact := !finite(v.Value) || !finite(v.Error)
+
+	if exact || v.Error == 0 {
+		exp = magnitude(v.Value)
+		if exact || notation == 'f' || (notation == 'g' && exp >= -4 && exp < 6) {
+			return strconv.FormatFloat(v.Value, 'f', -1, 64), strconv.FormatFloat(v.Error, 'f', -1, 64), 0
+		}
+		mantissa, e, _ := strings.Cut(strconv.FormatFloat(v.Value, 'e', -1, 64), "e")
+		exp, _ = strconv.Atoi(e)
+		return mantissa, "0", exp
+	}
+
+	pos := lastDigit(v.Error, n)
+	rv, re := roundTo(v.Value, pos), roundTo(v.Error, pos)
+
+	exp = magnitude(math.Max(math.Abs(rv), re))
+	if notation == 'f' || (notation == 'g' && exp >= -4 && exp < 6) {
+		exp = 0
+	}
+
+	decimals := max(exp-pos, 0)
+	scale := math.Pow10(exp)
+
+	return strconv.FormatFloat(rv/scale, 'f', decimals, 64), strconv.FormatFloat(re/scale, 'f', decimals, 64), exp
+}
+
+// String returns v with the value rounded to the last significant digit of the error, e.g. "1.235 ± 0.012".
+// It is the same as fmt.Sprint(v), see Format.
+func (v Uncertain) String() string {
+	value, err, exp := v.split(0, 'g')
+	return join(value, err, exp, exp != 0, " ± ")
+}
+
+// Concise returns v in the concise notation used in metrology, e.g. "1.2345(12)" or "6.62607015(81)e-34",
+// where the digits in parentheses are the error in units of the last digit of the value.
+// If the error has digits before the decimal point of the value, it is written as is: "12300(1200)".
+//
+// The error is rounded to n significant digits, or by the usual rule if n <= 0 (see lastDigit),
+// the scientific notation is used for large and small exponents, as in String.
+func (v Uncertain) Concise(n int) string {
+	value, err, exp := v.split(n, 'g')
+
+	if strings.Contains(value, ".") {
+		err = strings.TrimLeft(strings.Replace(err, ".", "", 1), "0")
+		if err == "" {
+			err = "0"
+		}
+	}
+
+	s := value + "(" + err + ")"
+	if exp != 0 {
+		s += fmt.Sprintf("e%+03d", exp)
+	}
+	return s
+}
+
+// join returns "value±err" with the given separator, or "(value±err)e+06" in the scientific notation.
+func join(value, err string, exp int, scientific bool, separator string) string {
+	if !scientific {
+		return value + separator + err
+	}
+	return "(" + value + separator + err + ")" + fmt.Sprintf("e%+03d", exp)
+}
+
+// Format implements fmt.Formatter.
+//
+// The error is rounded to 1 or 2 significant digits (see lastDigit) and the value is rounded to the last digit of the error:
+//
+//	fmt.Sprint(Uncertain{1.2345678, 0.0123456}) // "1.235 ± 0.012"
+//
+// Verbs are:
+//
+//	%v, %s, %g - fixed notation, or scientific notation "(6.6261 ± 0.0012)e-34" for large and small exponents
+//	%f - fixed notation
+//	%e - scientific notation
+//
+// Flags are:
+//
+//	precision - number of significant digits of the error, e.g. %.3v
+//	width - minimal width of the result, '-' pads it on the right
+//	'+' - always print a sign of the value
+//	' ' - leave a space for the sign of a positive value
+//	'#' - Go syntax with full precision for %v: "uncertain.Uncertain{Value:1.2345678, Error:0.0123456}",
+//	      ASCII "+/-" instead of "±" for other verbs
+func (v Uncertain) Format(f fmt.State, verb rune) {
+	var notation byte
+
+	switch verb {
+	case 'v', 's', 'g':
+		notation = 'g'
+	case 'f', 'F':
+		notation = 'f'
+	case 'e', 'E':
+		notation = 'e'
+	default:
+		fmt.Fprintf(f, "%%!%c(uncertain.Uncertain=%s)", verb, v.String())
+		return
+	}
+
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprintf(f, "uncertain.Uncertain{Value:%#v, Error:%#v}", v.Value, v.Error)
+		return
+	}
+
+	n, ok := f.Precision()
+	if !ok {
+		n = 0
+	}
+
+	value, err, exp := v.split(n, notation)
+
+	if !strings.HasPrefix(value, "-") {
+		if f.Flag('+') {
+			value = "+" + value
+		} else if f.Flag(' ') {
+			value = " " + value
+		}
+	}
+
+	separator := " ± "
+	if f.Flag('#') {
+		separator = " +/- "
+	}
+
+	s := join(value, err, exp, notation == 'e' || exp != 0, separator)
+
+	if width, ok := f.Width(); ok {
+		padding := strings.Repeat(" ", max(width-len([]rune(s)), 0))
+		if f.Flag('-') {
+			s += padding
+		} else {
+			s = padding + s
+		}
+	}
+
+	fmt.Fprint(f, s)
+}
@@ -0,0 +1,95 @@
+package uncertain
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestString(t *testing.T) {
+	cases := []struct {
+		v   Uncertain
+		res string
+	}{
+		{Uncertain{1.2345678, 0.0123456}, "1.235 ± 0.012"},
+		{Uncertain{1.2345678, 0.0456}, "1.23 ± 0.05"},
+		{Uncertain{1.2345678, 0.0097}, "1.235 ± 0.010"},
+		{Uncertain{100, 0.0999}, "100.00 ± 0.10"},
+		{Uncertain{-2.5, 0.5}, "-2.5 ± 0.5"},
+		{Uncertain{12345, 1234}, "12300 ± 1200"},
+		{Uncertain{1234567, 12}, "(1.234567 ± 0.000012)e+06"},
+		{Uncertain{6.62607015e-34, 8.1e-42}, "(6.62607015 ± 0.00000008)e-34"},
+		{Uncertain{3.5, 0}, "3.5 ± 0"},
+		{Uncertain{1e-7, 0}, "(1 ± 0)e-07"},
+		{Uncertain{0, 0}, "0 ± 0"},
+		{Uncertain{math.NaN(), 1}, "NaN ± 1"},
+		{Uncertain{1, math.Inf(1)}, "1 ± +Inf"},
+	}
+
+	for i, the_case := range cases {
+		if s := the_case.v.String(); s != the_case.res {
+			t.Fatalf("Test case %d failed: %#v is %q, got %q", i, the_case.v, the_case.res, s)
+		}
+		if s := fmt.Sprint(the_case.v); s != the_case.res {
+			t.Fatalf("Test case %d failed: fmt.Sprint(%#v) is %q, got %q", i, the_case.v, the_case.res, s)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	v := Uncertain{1.2345678, 0.0123456}
+
+	cases := []struct {
+		format string
+		v      Uncertain
+		res    string
+	}{
+		{"%v", v, "1.235 ± 0.012"},
+		{"%s", v, "1.235 ± 0.012"},
+		{"%.1v", v, "1.23 ± 0.01"},
+		{"%.3v", v, "1.2346 ± 0.0123"},
+		{"%+v", v, "+1.235 ± 0.012"},
+		{"% v", v, " 1.235 ± 0.012"},
+		{"%+v", Uncertain{-1, 0.5}, "-1.0 ± 0.5"},
+		{"%#v", v, "uncertain.Uncertain{Value:1.2345678, Error:0.0123456}"},
+		{"%#s", v, "1.235 +/- 0.012"},
+		{"%e", v, "(1.235 ± 0.012)e+00"},
+		{"%.1e", Uncertain{12345, 1234}, "(1.2 ± 0.1)e+04"},
+		{"%f", Uncertain{1234567, 12}, "1234567 ± 12"},
+		{"%f", Uncertain{6.62607015e-34, 8.1e-42}, "0.000000000000000000000000000000000662607015 ± 0.000000000000000000000000000000000000000008"},
+		{"%18v", v, "     1.235 ± 0.012"},
+		{"%-18v|", v, "1.235 ± 0.012     |"},
+		{"%d", v, "%!d(uncertain.Uncertain=1.235 ± 0.012)"},
+	}
+
+	for i, the_case := range cases {
+		if s := fmt.Sprintf(the_case.format, the_case.v); s != the_case.res {
+			t.Fatalf("Test case %d failed: %q of %#v is %q, got %q", i, the_case.format, the_case.v, the_case.res, s)
+		}
+	}
+}
+
+func TestConcise(t *testing.T) {
+	cases := []struct {
+		v   Uncertain
+		n   int
+		res string
+	}{
+		{Uncertain{1.2345678, 0.0012345}, 0, "1.2346(12)"},
+		{Uncertain{1.2345678, 0.0456}, 0, "1.23(5)"},
+		{Uncertain{1.2345678, 0.0097}, 0, "1.235(10)"},
+		{Uncertain{12.345, 1.234}, 0, "12.3(12)"},
+		{Uncertain{12345, 1234}, 0, "12300(1200)"},
+		{Uncertain{-2.5, 0.5}, 0, "-2.5(5)"},
+		{Uncertain{6.62607015e-34, 8.1e-41}, 2, "6.62607015(81)e-34"},
+		{Uncertain{6.62607015e-34, 8.1e-41}, 0, "6.6260702(8)e-34"},
+		{Uncertain{1234567, 12}, 0, "1.234567(12)e+06"},
+		{Uncertain{3.5, 0}, 0, "3.5(0)"},
+	}
+
+	for i, the_case := range cases {
+		if s := the_case.v.Concise(the_case.n); s != the_case.res {
+			t.Fatalf("Test case %d failed: %#v is %q, got %q", i, the_case.v, the_case.res, s)
+		}
+	}
+}
@@ -1,10 +1,92 @@
 package uncertain
 
-// TODO
-//func Cosh(x float64) float64
+import "math"
 
-// TODO
-//func Sinh(x float64) float64
+// Cosh returns the hyperbolic cosine of v.Value and propagates error.
+//
+// Special cases are:
+//
+//	Cosh({±0, e}) = {1, 0}
+//	Cosh({x, 0}) = {cosh(x), 0}
+//	Cosh({±Inf, e}) = {+Inf, +Inf}
+//	Cosh({NaN, e}) = {NaN, _}
+//
+// Very large values overflow to {+Inf, +Inf}.
+func Cosh(v Uncertain) (result Uncertain) {
+	result.Value = math.Cosh(v.Value)
 
-// TODO
-//func Tanh(x float64) float64
+	if v.Error == 0 {
+		result.Error = 0
+		return
+	}
+
+	result.Error = math.Abs(math.Sinh(v.Value) * v.Error)
+	return
+}
+
+// Sinh returns the hyperbolic sine of v.Value and propagates error.
+//
+// Special cases are:
+//
+//	Sinh({±0, e}) = {±0, e}
+//	Sinh({x, 0}) = {sinh(x), 0}
+//	Sinh({±Inf, e}) = {±Inf, +Inf}
+//	Sinh({NaN, e}) = {NaN, _}
+//
+// Very large values overflow to {±Inf, +Inf}.
+func Sinh(v Uncertain) (result Uncertain) {
+	result.Value = math.Sinh(v.Value)
+
+	if v.Error == 0 {
+		result.Error = 0
+		return
+	}
+
+	result.Error = math.Cosh(v.Value) * v.Error
+	return
+}
+
+// Sinhcosh returns Sinh(v), Cosh(v).
+//
+// Special cases are:
+//
+//	Sinhcosh({±0, e}) = {±0, e}, {1, 0}
+//	Sinhcosh({x, 0}) = {sinh(x), 0}, {cosh(x), 0}
+//	Sinhcosh({±Inf, e}) = {±Inf, +Inf}, {+Inf, +Inf}
+//	Sinhcosh({NaN, e}) = {NaN, _}, {NaN, _}
+func Sinhcosh(v Uncertain) (sinh, cosh Uncertain) {
+	s, c := math.Sinh(v.Value), math.Cosh(v.Value)
+
+	sinh.Value = s
+	cosh.Value = c
+
+	if v.Error == 0 {
+		return
+	}
+
+	sinh.Error = c * v.Error
+	cosh.Error = math.Abs(s * v.Error)
+
+	return
+}
+
+// Tanh returns the hyperbolic tangent of v.Value and propagates error.
+//
+// Special cases are:
+//
+//	Tanh({±0, e}) = {±0, e}
+//	Tanh({x, 0}) = {tanh(x), 0}
+//	Tanh({±Inf, e}) = {±1, 0}
+//	Tanh({NaN, e}) = {NaN, _}
+func Tanh(v Uncertain) (result Uncertain) {
+	result.Value = math.Tanh(v.Value)
+
+	if v.Error == 0 {
+		result.Error = 0
+		return
+	}
+
+	c := math.Cosh(v.Value)
+	result.Error = v.Error / (c * c)
+	return
+}
@@ -0,0 +1,177 @@
+package uncertain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCosh(t *testing.T) {
+	cases := [][2]Uncertain{
+		{{0, 0}, {1, 0}},
+		{{math.Inf(1), 0.1}, {math.Inf(1), math.Inf(1)}},
+		{{math.Inf(-1), 0.1}, {math.Inf(1), math.Inf(1)}},
+		{{math.NaN(), 0.1}, {math.NaN(), 0}},
+		{{1000, 0}, {math.Inf(1), 0}},
+		{{1000, 0.1}, {math.Inf(1), math.Inf(1)}},
+		{{-1000, 0.1}, {math.Inf(1), math.Inf(1)}},
+
+		{{0, 0.1}, {1, 0}},
+		{{0.5, 0}, {1.12762596520638, 0}},
+		{{0.5, 0.1}, {1.12762596520638, 0.0521095305493747}},
+		{{-0.5, 0.1}, {1.12762596520638, 0.0521095305493747}},
+		{{1, 0.05}, {1.54308063481524, 0.0587600596821901}},
+		{{-1, 0.05}, {1.54308063481524, 0.0587600596821901}},
+		{{2, 0.2}, {3.76219569108363, 0.725372081569404}},
+		{{-2, 0.2}, {3.76219569108363, 0.725372081569404}},
+		{{10, 0.01}, {11013.2329201033, 110.132328747034}},
+		{{20, 0.1}, {242582597.704895, 24258259.7704895}},
+	}
+
+	for i, the_case := range cases {
+		res := Cosh(the_case[0])
+
+		if math.IsNaN(res.Value) {
+			if !math.IsNaN(the_case[1].Value) {
+				t.Fatalf("Test case %d failed: Cosh(%f±%f) is %f±%f, got %f±%f",
+					i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+			} else {
+				continue
+			}
+		}
+
+		if !almostEqual(res, the_case[1]) {
+			t.Fatalf("Test case %d failed: Cosh(%f±%f) is %f±%f, got %f±%f",
+				i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+		}
+	}
+}
+
+func TestSinh(t *testing.T) {
+	negative_zero := math.Copysign(0.0, -1)
+
+	cases := [][2]Uncertain{
+		{{negative_zero, 0.1}, {negative_zero, 0.1}},
+		{{0, 0}, {0, 0}},
+		{{math.Inf(1), 0.1}, {math.Inf(1), math.Inf(1)}},
+		{{math.Inf(-1), 0.1}, {math.Inf(-1), math.Inf(1)}},
+		{{math.NaN(), 0.1}, {math.NaN(), 0}},
+		{{1000, 0}, {math.Inf(1), 0}},
+		{{1000, 0.1}, {math.Inf(1), math.Inf(1)}},
+		{{-1000, 0.1}, {math.Inf(-1), math.Inf(1)}},
+
+		{{0, 0.1}, {0, 0.1}},
+		{{0.5, 0}, {0.521095305493747, 0}},
+		{{0.5, 0.1}, {0.521095305493747, 0.112762596520638}},
+		{{-0.5, 0.1}, {-0.521095305493747, 0.112762596520638}},
+		{{1, 0.05}, {1.1752011936438, 0.0771540317407622}},
+		{{-1, 0.05}, {-1.1752011936438, 0.0771540317407622}},
+		{{2, 0.2}, {3.62686040784702, 0.752439138216726}},
+		{{-2, 0.2}, {-3.62686040784702, 0.752439138216726}},
+		{{10, 0.01}, {11013.2328747034, 110.132329201033}},
+		{{20, 0.1}, {242582597.704895, 24258259.7704895}},
+	}
+
+	for i, the_case := range cases {
+		res := Sinh(the_case[0])
+
+		if math.IsNaN(res.Value) {
+			if !math.IsNaN(the_case[1].Value) {
+				t.Fatalf("Test case %d failed: Sinh(%f±%f) is %f±%f, got %f±%f",
+					i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+			} else {
+				continue
+			}
+		}
+
+		if !almostEqual(res, the_case[1]) {
+			t.Fatalf("Test case %d failed: Sinh(%f±%f) is %f±%f, got %f±%f",
+				i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+		}
+	}
+}
+
+func TestSinhcosh(t *testing.T) {
+	cases := [][3]Uncertain{
+		{{0, 0}, {0, 0}, {1, 0}},
+		{{math.Inf(1), 0.1}, {math.Inf(1), math.Inf(1)}, {math.Inf(1), math.Inf(1)}},
+		{{math.Inf(-1), 0.1}, {math.Inf(-1), math.Inf(1)}, {math.Inf(1), math.Inf(1)}},
+		{{math.NaN(), 0.1}, {math.NaN(), 0}, {math.NaN(), 0}},
+		{{1000, 0}, {math.Inf(1), 0}, {math.Inf(1), 0}},
+		{{1000, 0.1}, {math.Inf(1), math.Inf(1)}, {math.Inf(1), math.Inf(1)}},
+		{{-1000, 0.1}, {math.Inf(-1), math.Inf(1)}, {math.Inf(1), math.Inf(1)}},
+
+		{{0, 0.1}, {0, 0.1}, {1, 0}},
+		{{0.5, 0}, {0.521095305493747, 0}, {1.12762596520638, 0}},
+		{{0.5, 0.1}, {0.521095305493747, 0.112762596520638}, {1.12762596520638, 0.0521095305493747}},
+		{{-0.5, 0.1}, {-0.521095305493747, 0.112762596520638}, {1.12762596520638, 0.0521095305493747}},
+		{{1, 0.05}, {1.1752011936438, 0.0771540317407622}, {1.54308063481524, 0.0587600596821901}},
+		{{-1, 0.05}, {-1.1752011936438, 0.0771540317407622}, {1.54308063481524, 0.0587600596821901}},
+		{{2, 0.2}, {3.62686040784702, 0.752439138216726}, {3.76219569108363, 0.725372081569404}},
+		{{-2, 0.2}, {-3.62686040784702, 0.752439138216726}, {3.76219569108363, 0.725372081569404}},
+		{{10, 0.01}, {11013.2328747034, 110.132329201033}, {11013.2329201033, 110.132328747034}},
+		{{20, 0.1}, {242582597.704895, 24258259.7704895}, {242582597.704895, 24258259.7704895}},
+	}
+
+	for i, the_case := range cases {
+		sinh, cosh := Sinhcosh(the_case[0])
+
+		if math.IsNaN(sinh.Value) || math.IsNaN(cosh.Value) {
+			if !math.IsNaN(the_case[2].Value) {
+				t.Fatalf("Test case %d failed: Sinh(%f±%f) is %f±%f, got %f±%f, Cosh(%f±%f) is %f±%f, got %f±%f",
+					i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, sinh.Value, sinh.Error,
+					the_case[0].Value, the_case[0].Error, the_case[2].Value, the_case[2].Error, cosh.Value, cosh.Error,
+				)
+			} else {
+				continue
+			}
+		}
+
+		if !(almostEqual(sinh, the_case[1]) && almostEqual(cosh, the_case[2])) {
+			t.Fatalf("Test case %d failed: Sinh(%f±%f) is %f±%f, got %f±%f, Cosh(%f±%f) is %f±%f, got %f±%f",
+				i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, sinh.Value, sinh.Error,
+				the_case[0].Value, the_case[0].Error, the_case[2].Value, the_case[2].Error, cosh.Value, cosh.Error,
+			)
+		}
+	}
+}
+
+func TestTanh(t *testing.T) {
+	cases := [][2]Uncertain{
+		{{0, 0}, {0, 0}},
+		{{math.Inf(1), 0.1}, {1, 0}},
+		{{math.Inf(-1), 0.1}, {-1, 0}},
+		{{math.NaN(), 0.1}, {math.NaN(), 0}},
+		{{1000, 0}, {1, 0}},
+		{{1000, 0.1}, {1, 0}},
+		{{-1000, 0.1}, {-1, 0}},
+
+		{{0, 0.1}, {0, 0.1}},
+		{{0.5, 0}, {0.46211715726001, 0}},
+		{{0.5, 0.1}, {0.46211715726001, 0.0786447732965928}},
+		{{-0.5, 0.1}, {-0.46211715726001, 0.0786447732965928}},
+		{{1, 0.05}, {0.761594155955765, 0.0209987170807013}},
+		{{-1, 0.05}, {-0.761594155955765, 0.0209987170807013}},
+		{{2, 0.2}, {0.964027580075817, 0.0141301649706329}},
+		{{-2, 0.2}, {-0.964027580075817, 0.0141301649706329}},
+		{{10, 0.01}, {0.999999995877693, 8.2446144557674e-11}},
+		{{20, 0.1}, {1, 1.69934170211664e-18}},
+	}
+
+	for i, the_case := range cases {
+		res := Tanh(the_case[0])
+
+		if math.IsNaN(res.Value) {
+			if !math.IsNaN(the_case[1].Value) {
+				t.Fatalf("Test case %d failed: Tanh(%f±%f) is %f±%f, got %f±%f",
+					i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+			} else {
+				continue
+			}
+		}
+
+		if !almostEqual(res, the_case[1]) {
+			t.Fatalf("Test case %d failed: Tanh(%f±%f) is %f±%f, got %f±%f",
+				i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+		}
+	}
+}
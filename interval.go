@@ -0,0 +1,325 @@
+package uncertain
+
+import "math"
+
+// Interval is a closed interval [Lo, Hi] of real numbers.
+//
+// Operations on intervals return rigorous enclosures: the result contains f(x) for every x in the argument.
+// To compensate for floating point rounding, bounds of every result are rounded outward,
+// and extrema of non-monotonic functions inside the interval are taken into account.
+//
+// An Interval with NaN bounds is empty. It is returned if an argument is out of the domain of a function.
+type Interval struct {
+	Lo float64
+	Hi float64
+}
+
+// Interval returns the interval v.Value±v.Error.
+func (v Uncertain) Interval() Interval {
+	return outward(v.Value-v.Error, v.Value+v.Error, 1)
+}
+
+// Uncertain returns the midpoint of i as a value and its half-width as an error.
+//
+// Special cases are:
+//
+//	Interval{-Inf, +Inf}.Uncertain() = {0, +Inf}
+//	Interval{x, +Inf}.Uncertain() = {x, +Inf}
+//	Interval{-Inf, x}.Uncertain() = {x, +Inf}
+//	Interval{NaN, NaN}.Uncertain() = {NaN, NaN}
+func (i Interval) Uncertain() (result Uncertain) {
+	if i.IsEmpty() {
+		return Uncertain{math.NaN(), math.NaN()}
+	}
+
+	loInf, hiInf := math.IsInf(i.Lo, -1), math.IsInf(i.Hi, 1)
+	switch {
+	case loInf && hiInf:
+		return Uncertain{0, math.Inf(1)}
+	case loInf:
+		return Uncertain{i.Hi, math.Inf(1)}
+	case hiInf:
+		return Uncertain{i.Lo, math.Inf(1)}
+	}
+
+	result.Value = i.Lo/2 + i.Hi/2
+	result.Error = math.Nextafter(math.Max(i.Hi-result.Value, result.Value-i.Lo), math.Inf(1))
+	return
+}
+
+// IsEmpty reports whether i is empty.
+func (i Interval) IsEmpty() bool {
+	return math.IsNaN(i.Lo) || math.IsNaN(i.Hi) || i.Lo > i.Hi
+}
+
+// Contains reports whether x is in i.
+func (i Interval) Contains(x float64) bool {
+	return i.Lo <= x && x <= i.Hi
+}
+
+// Width returns Hi - Lo.
+func (i Interval) Width() float64 {
+	return i.Hi - i.Lo
+}
+
+// empty returns an empty interval.
+func empty() Interval {
+	return Interval{math.NaN(), math.NaN()}
+}
+
+// entire returns the interval of all real numbers.
+func entire() Interval {
+	return Interval{math.Inf(-1), math.Inf(1)}
+}
+
+// outward returns the interval [lo, hi] with each bound rounded outward by the given number of ulps.
+func outward(lo, hi float64, ulps int) Interval {
+	for n := 0; n < ulps; n++ {
+		lo = math.Nextafter(lo, math.Inf(-1))
+		hi = math.Nextafter(hi, math.Inf(1))
+	}
+	return Interval{lo, hi}
+}
+
+// transcendentalULPs is the outward rounding of results of math library functions,
+// which are less accurate than arithmetic operations.
+const transcendentalULPs = 2
+
+// Add returns the sum of i1 and i2.
+func (i1 Interval) Add(i2 Interval) Interval {
+	return outward(i1.Lo+i2.Lo, i1.Hi+i2.Hi, 1)
+}
+
+// Sub returns the difference between i1 and i2.
+func (i1 Interval) Sub(i2 Interval) Interval {
+	return outward(i1.Lo-i2.Hi, i1.Hi-i2.Lo, 1)
+}
+
+// Mul returns the product of i1 and i2. Product of zero and infinite bounds is taken as zero.
+func (i1 Interval) Mul(i2 Interval) Interval {
+	if i1.IsEmpty() || i2.IsEmpty() {
+		return empty()
+	}
+
+	var val [4]float64
+
+	val[0] = i1.Lo * i2.Lo
+	val[1] = i1.Lo * i2.Hi
+	val[2] = i1.Hi * i2.Lo
+	val[3] = i1.Hi * i2.Hi
+
+	for n, v := range val {
+		if math.IsNaN(v) { // 0 * Inf
+			val[n] = 0
+		}
+	}
+
+	min, max := val[0], val[0]
+
+	for _, v := range val {
+		min = math.Min(min, v)
+		max = math.Max(max, v)
+	}
+	return outward(min, max, 1)
+}
+
+// Div returns the quotient of dividend i1 and divisor i2.
+//
+// Special case is:
+//
+//	i1.Div(i2) = {-Inf, +Inf} if i2 contains zero
+func (i1 Interval) Div(i2 Interval) Interval {
+	if i1.IsEmpty() || i2.IsEmpty() {
+		return empty()
+	}
+	if i2.Contains(0) {
+		return entire()
+	}
+
+	reciprocal := outward(1/i2.Hi, 1/i2.Lo, 1)
+	return i1.Mul(reciprocal)
+}
+
+// Sqrt returns the square root of i. Negative part of the interval is ignored.
+//
+// Special case is:
+//
+//	Interval{lo, hi}.Sqrt() = {NaN, NaN} if hi < 0
+func (i Interval) Sqrt() Interval {
+	if i.IsEmpty() || i.Hi < 0 {
+		return empty()
+	}
+
+	lo := math.Max(i.Lo, 0)
+	result := outward(math.Sqrt(lo), math.Sqrt(i.Hi), 1)
+	result.Lo = math.Max(result.Lo, 0)
+	return result
+}
+
+// Sin returns the sine of the radian interval i.
+func (i Interval) Sin() Interval {
+	return i.periodic(math.Sin, math.Pi/2, -math.Pi/2)
+}
+
+// Cos returns the cosine of the radian interval i.
+func (i Interval) Cos() Interval {
+	return i.periodic(math.Cos, 0, math.Pi)
+}
+
+// periodic returns f(i) for 2Pi-periodic f with range [-1, 1],
+// which has maxima at maxAt+2Pi*k and minima at minAt+2Pi*k.
+func (i Interval) periodic(f func(float64) float64, maxAt, minAt float64) Interval {
+	if i.IsEmpty() || math.IsInf(i.Lo, 0) || math.IsInf(i.Hi, 0) {
+		return empty()
+	}
+	if i.Width() >= 2*math.Pi {
+		return Interval{-1, 1}
+	}
+
+	lo, hi := f(i.Lo), f(i.Hi)
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+	result := outward(lo, hi, transcendentalULPs)
+
+	if i.containsPeriodic(maxAt, 2*math.Pi) {
+		result.Hi = 1
+	}
+	if i.containsPeriodic(minAt, 2*math.Pi) {
+		result.Lo = -1
+	}
+
+	result.Lo = math.Max(result.Lo, -1)
+	result.Hi = math.Min(result.Hi, 1)
+	return result
+}
+
+// containsPeriodic reports whether i contains any of the points offset+period*k.
+// Points just outside i are reported too, as the rounding error of the points is unknown.
+func (i Interval) containsPeriodic(offset, period float64) bool {
+	k := math.Floor((i.Lo - offset) / period)
+	margin := 8 * ulp(math.Max(math.Max(math.Abs(i.Lo), math.Abs(i.Hi)), period))
+
+	for n := 0.0; n <= 2; n++ {
+		x := offset + (k+n)*period
+		if x >= i.Lo-margin && x <= i.Hi+margin {
+			return true
+		}
+	}
+	return false
+}
+
+// ulp returns the distance from x to the next float64 towards +Inf.
+func ulp(x float64) float64 {
+	return math.Nextafter(x, math.Inf(1)) - x
+}
+
+// Tan returns the tangent of the radian interval i.
+//
+// Special case is:
+//
+//	i.Tan() = {-Inf, +Inf} if i contains a pole Pi/2+Pi*k
+func (i Interval) Tan() Interval {
+	if i.IsEmpty() || math.IsInf(i.Lo, 0) || math.IsInf(i.Hi, 0) {
+		return empty()
+	}
+	if i.Width() >= math.Pi || i.containsPeriodic(math.Pi/2, math.Pi) {
+		return entire()
+	}
+
+	return outward(math.Tan(i.Lo), math.Tan(i.Hi), transcendentalULPs)
+}
+
+// Asin returns the arcsine, in radians, of i. Part of the interval outside [-1, 1] is ignored.
+//
+// Special case is:
+//
+//	i.Asin() = {NaN, NaN} if i doesn't intersect [-1, 1]
+func (i Interval) Asin() Interval {
+	lo, hi, ok := i.clamp(-1, 1)
+	if !ok {
+		return empty()
+	}
+
+	result := outward(math.Asin(lo), math.Asin(hi), transcendentalULPs)
+	result.Lo = math.Max(result.Lo, -math.Nextafter(math.Pi/2, math.Inf(1)))
+	result.Hi = math.Min(result.Hi, math.Nextafter(math.Pi/2, math.Inf(1)))
+	return result
+}
+
+// Acos returns the arccosine, in radians, of i. Part of the interval outside [-1, 1] is ignored.
+//
+// Special case is:
+//
+//	i.Acos() = {NaN, NaN} if i doesn't intersect [-1, 1]
+func (i Interval) Acos() Interval {
+	lo, hi, ok := i.clamp(-1, 1)
+	if !ok {
+		return empty()
+	}
+
+	result := outward(math.Acos(hi), math.Acos(lo), transcendentalULPs)
+	result.Lo = math.Max(result.Lo, 0)
+	result.Hi = math.Min(result.Hi, math.Nextafter(math.Pi, math.Inf(1)))
+	return result
+}
+
+// clamp returns the intersection of i and [min, max].
+func (i Interval) clamp(min, max float64) (lo, hi float64, ok bool) {
+	if i.IsEmpty() || i.Hi < min || i.Lo > max {
+		return math.NaN(), math.NaN(), false
+	}
+	return math.Max(i.Lo, min), math.Min(i.Hi, max), true
+}
+
+// Atan returns the arctangent, in radians, of i.
+func (i Interval) Atan() Interval {
+	if i.IsEmpty() {
+		return empty()
+	}
+
+	result := outward(math.Atan(i.Lo), math.Atan(i.Hi), transcendentalULPs)
+	result.Lo = math.Max(result.Lo, -math.Nextafter(math.Pi/2, math.Inf(1)))
+	result.Hi = math.Min(result.Hi, math.Nextafter(math.Pi/2, math.Inf(1)))
+	return result
+}
+
+// Atan2 returns the arc tangent of y/x for all points of the box y×x.
+//
+// Special cases are:
+//
+//	y.Atan2(x) = {-Pi, Pi} if the box contains the origin
+//	y.Atan2(x) = {-Pi, Pi} if the box crosses the branch cut along the negative x axis
+func (y Interval) Atan2(x Interval) Interval {
+	if y.IsEmpty() || x.IsEmpty() {
+		return empty()
+	}
+
+	full := outward(-math.Pi, math.Pi, 1)
+
+	if x.Contains(0) && y.Contains(0) {
+		return full
+	}
+	if x.Lo < 0 && y.Lo < 0 && y.Hi >= 0 {
+		return full
+	}
+
+	var res [4]float64
+
+	res[0] = math.Atan2(y.Lo, x.Lo)
+	res[1] = math.Atan2(y.Lo, x.Hi)
+	res[2] = math.Atan2(y.Hi, x.Lo)
+	res[3] = math.Atan2(y.Hi, x.Hi)
+
+	min, max := res[0], res[0]
+
+	for _, r := range res {
+		min = math.Min(min, r)
+		max = math.Max(max, r)
+	}
+
+	result := outward(min, max, transcendentalULPs)
+	result.Lo = math.Max(result.Lo, full.Lo)
+	result.Hi = math.Min(result.Hi, full.Hi)
+	return result
+}
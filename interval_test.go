@@ -0,0 +1,200 @@
+package uncertain
+
+import (
+	"math"
+	"testing"
+)
+
+// checkEnclosure checks that f(x) is in the result of g for points x of every interval.
+func checkEnclosure(t *testing.T, name string, f func(float64) float64, g func(Interval) Interval, intervals []Interval) {
+	t.Helper()
+
+	const points = 1000
+
+	for i, in := range intervals {
+		res := g(in)
+
+		for n := 0; n <= points; n++ {
+			x := in.Lo + (in.Hi-in.Lo)*float64(n)/points
+			y := f(x)
+			if math.IsNaN(y) {
+				continue
+			}
+			if !res.Contains(y) {
+				t.Fatalf("Test case %d failed: %s([%f, %f]) = [%.17g, %.17g] doesn't contain %s(%.17g) = %.17g",
+					i, name, in.Lo, in.Hi, res.Lo, res.Hi, name, x, y)
+			}
+		}
+	}
+}
+
+var testIntervals = []Interval{
+	{0, 0}, {0, 1}, {-1, 1}, {-0.5, 0.25}, {0.1, 0.2}, {-3, -2}, {1, 2}, {1.5, 1.6},
+	{-math.Pi / 2, math.Pi / 2}, {0, math.Pi}, {3, 4}, {-7, -5}, {4, 10}, {100, 101}, {-0.999, 0.999},
+}
+
+func TestIntervalUncertain(t *testing.T) {
+	cases := []struct {
+		v Uncertain
+		i Interval
+	}{
+		{Uncertain{0, 0}, Interval{0, 0}},
+		{Uncertain{1, 0.5}, Interval{0.5, 1.5}},
+		{Uncertain{-10, 2}, Interval{-12, -8}},
+		{Uncertain{0, math.Inf(1)}, Interval{math.Inf(-1), math.Inf(1)}},
+		{Uncertain{3, math.Inf(1)}, Interval{3, math.Inf(1)}},
+	}
+
+	for n, the_case := range cases {
+		i := the_case.v.Interval()
+		if !i.Contains(the_case.i.Lo) || !i.Contains(the_case.i.Hi) {
+			t.Fatalf("Test case %d failed: interval of %f±%f must contain [%f, %f], got [%f, %f]",
+				n, the_case.v.Value, the_case.v.Error, the_case.i.Lo, the_case.i.Hi, i.Lo, i.Hi)
+		}
+
+		v := the_case.i.Uncertain()
+		if !almostEqual(v, the_case.v) || v.Error < the_case.v.Error {
+			t.Fatalf("Test case %d failed: [%f, %f] is %f±%f, got %f±%f",
+				n, the_case.i.Lo, the_case.i.Hi, the_case.v.Value, the_case.v.Error, v.Value, v.Error)
+		}
+	}
+
+	if v := empty().Uncertain(); !math.IsNaN(v.Value) || !math.IsNaN(v.Error) {
+		t.Fatalf("Empty interval is NaN±NaN, got %f±%f", v.Value, v.Error)
+	}
+}
+
+func TestIntervalArithmetics(t *testing.T) {
+	for i, i1 := range testIntervals {
+		for j, i2 := range testIntervals {
+			ops := []struct {
+				name string
+				f    func(x, y float64) float64
+				g    func(i1, i2 Interval) Interval
+			}{
+				{"Add", func(x, y float64) float64 { return x + y }, Interval.Add},
+				{"Sub", func(x, y float64) float64 { return x - y }, Interval.Sub},
+				{"Mul", func(x, y float64) float64 { return x * y }, Interval.Mul},
+				{"Div", func(x, y float64) float64 { return x / y }, Interval.Div},
+			}
+
+			for _, op := range ops {
+				res := op.g(i1, i2)
+				for _, x := range []float64{i1.Lo, (i1.Lo + i1.Hi) / 2, i1.Hi} {
+					for _, y := range []float64{i2.Lo, (i2.Lo + i2.Hi) / 2, i2.Hi} {
+						z := op.f(x, y)
+						if !math.IsNaN(z) && !res.Contains(z) {
+							t.Fatalf("Test case %d, %d failed: [%f, %f].%s([%f, %f]) = [%f, %f] doesn't contain %f",
+								i, j, i1.Lo, i1.Hi, op.name, i2.Lo, i2.Hi, res.Lo, res.Hi, z)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if res := (Interval{1, 2}).Div(Interval{-1, 1}); res != entire() {
+		t.Fatalf("Division by interval containing zero is entire, got [%f, %f]", res.Lo, res.Hi)
+	}
+	if res := (Interval{0, 1}).Mul(Interval{1, math.Inf(1)}); res.Lo > 0 || res.Hi != math.Inf(1) {
+		t.Fatalf("[0, 1]*[1, +Inf] is [0, +Inf], got [%f, %f]", res.Lo, res.Hi)
+	}
+}
+
+func TestIntervalSqrt(t *testing.T) {
+	checkEnclosure(t, "Sqrt", math.Sqrt, Interval.Sqrt, testIntervals)
+
+	if res := (Interval{-2, -1}).Sqrt(); !res.IsEmpty() {
+		t.Fatalf("Sqrt of negative interval is empty, got [%f, %f]", res.Lo, res.Hi)
+	}
+	if res := (Interval{-1, 4}).Sqrt(); res.Lo != 0 || !res.Contains(2) || res.Hi > 2.000001 {
+		t.Fatalf("Sqrt([-1, 4]) is [0, 2], got [%f, %f]", res.Lo, res.Hi)
+	}
+}
+
+func TestIntervalSin(t *testing.T) {
+	checkEnclosure(t, "Sin", math.Sin, Interval.Sin, testIntervals)
+
+	cases := [][2]Interval{
+		{{1, 2}, {0.84147098, 1}},
+		{{0, math.Pi}, {0, 1}},
+		{{4, 5}, {-1, -0.7568025}},
+		{{0, 10}, {-1, 1}},
+		{{0.1, 0.2}, {0.09983341, 0.19866933}},
+	}
+
+	for i, the_case := range cases {
+		res := the_case[0].Sin()
+		if math.Abs(res.Lo-the_case[1].Lo) > 1e-7 || math.Abs(res.Hi-the_case[1].Hi) > 1e-7 {
+			t.Fatalf("Test case %d failed: Sin([%f, %f]) is [%f, %f], got [%.10f, %.10f]",
+				i, the_case[0].Lo, the_case[0].Hi, the_case[1].Lo, the_case[1].Hi, res.Lo, res.Hi)
+		}
+	}
+}
+
+func TestIntervalCos(t *testing.T) {
+	checkEnclosure(t, "Cos", math.Cos, Interval.Cos, testIntervals)
+
+	cases := [][2]Interval{
+		{{-0.1, 0.1}, {0.99500417, 1}},
+		{{3, 4}, {-1, -0.65364362}},
+		{{1, 2}, {-0.41614684, 0.54030231}},
+		{{-10, 0}, {-1, 1}},
+	}
+
+	for i, the_case := range cases {
+		res := the_case[0].Cos()
+		if math.Abs(res.Lo-the_case[1].Lo) > 1e-7 || math.Abs(res.Hi-the_case[1].Hi) > 1e-7 {
+			t.Fatalf("Test case %d failed: Cos([%f, %f]) is [%f, %f], got [%.10f, %.10f]",
+				i, the_case[0].Lo, the_case[0].Hi, the_case[1].Lo, the_case[1].Hi, res.Lo, res.Hi)
+		}
+	}
+}
+
+func TestIntervalTan(t *testing.T) {
+	checkEnclosure(t, "Tan", math.Tan, Interval.Tan, testIntervals)
+
+	if res := (Interval{1.5, 1.6}).Tan(); res != entire() {
+		t.Fatalf("Tan over a pole is entire, got [%f, %f]", res.Lo, res.Hi)
+	}
+	if res := (Interval{-1, 1}).Tan(); res == entire() || !res.Contains(math.Tan(1)) {
+		t.Fatalf("Tan([-1, 1]) is [-1.557408, 1.557408], got [%f, %f]", res.Lo, res.Hi)
+	}
+}
+
+func TestIntervalInverseTrigonometric(t *testing.T) {
+	checkEnclosure(t, "Asin", math.Asin, Interval.Asin, testIntervals)
+	checkEnclosure(t, "Acos", math.Acos, Interval.Acos, testIntervals)
+	checkEnclosure(t, "Atan", math.Atan, Interval.Atan, testIntervals)
+
+	if res := (Interval{2, 3}).Asin(); !res.IsEmpty() {
+		t.Fatalf("Asin out of domain is empty, got [%f, %f]", res.Lo, res.Hi)
+	}
+	if res := (Interval{0.5, 3}).Acos(); res.Lo != 0 || !res.Contains(math.Acos(0.5)) {
+		t.Fatalf("Acos([0.5, 3]) is [0, 1.047198], got [%f, %f]", res.Lo, res.Hi)
+	}
+}
+
+func TestIntervalAtan2(t *testing.T) {
+	for i, y := range testIntervals {
+		for j, x := range testIntervals {
+			res := y.Atan2(x)
+			for _, yy := range []float64{y.Lo, (y.Lo + y.Hi) / 2, y.Hi} {
+				for _, xx := range []float64{x.Lo, (x.Lo + x.Hi) / 2, x.Hi} {
+					z := math.Atan2(yy, xx)
+					if !res.Contains(z) {
+						t.Fatalf("Test case %d, %d failed: [%f, %f].Atan2([%f, %f]) = [%f, %f] doesn't contain %f",
+							i, j, y.Lo, y.Hi, x.Lo, x.Hi, res.Lo, res.Hi, z)
+					}
+				}
+			}
+		}
+	}
+
+	if res := (Interval{-1, 1}).Atan2(Interval{-3, -2}); !res.Contains(-math.Pi+0.1) || !res.Contains(math.Pi-0.1) {
+		t.Fatalf("Atan2 over the branch cut is [-Pi, Pi], got [%f, %f]", res.Lo, res.Hi)
+	}
+	if res := (Interval{1, 2}).Atan2(Interval{1, 2}); res.Lo > math.Atan2(1, 2) || res.Hi < math.Atan2(2, 1) || res.Width() > 0.7 {
+		t.Fatalf("Atan2([1, 2], [1, 2]) is [0.463648, 1.107149], got [%f, %f]", res.Lo, res.Hi)
+	}
+}
@@ -1,10 +1,108 @@
 package uncertain
 
-// TODO
-//func Acosh(x float64) float64
+import "math"
 
-// TODO
-//func Asinh(x float64) float64
+// Acosh returns the inverse hyperbolic cosine of v.Value and propagates error.
+//
+// The derivative is infinite at the domain edge 1, so if the interval v.Value±v.Error
+// crosses or touches 1 the error is the larger of deviations to the ends of the interval within the domain.
+//
+// Special cases are:
+//
+//	Acosh({+Inf, e}) = {+Inf, 0}
+//	Acosh({1, e}) = {0, acosh(1+e)}
+//	Acosh({x, e}) = {acosh(x), max(acosh(x+e)-acosh(x), acosh(x))} if 1 < x <= 1+e
+//	Acosh({x, e}) = {NaN, _} if x < 1
+//	Acosh({NaN, e}) = {NaN, _}
+func Acosh(v Uncertain) (result Uncertain) {
+	result.Value = math.Acosh(v.Value)
 
-// TODO
-//func Atanh(x float64) float64
+	if v.Error == 0 {
+		result.Error = 0
+		return
+	}
+
+	if v.Value-v.Error <= 1 && v.Value >= 1 {
+		result.Error = math.Max(math.Acosh(v.Value+v.Error)-result.Value, result.Value)
+		return
+	}
+
+	result.Error = v.Error / math.Sqrt(v.Value*v.Value-1)
+	return
+}
+
+// Arccosh is a synonym for Acosh
+func Arccosh(v Uncertain) (result Uncertain) {
+	return Acosh(v)
+}
+
+// Asinh returns the inverse hyperbolic sine of v.Value and propagates error.
+//
+// Special cases are:
+//
+//	Asinh({±0, e}) = {±0, e}
+//	Asinh({±Inf, e}) = {±Inf, 0}
+//	Asinh({NaN, e}) = {NaN, _}
+func Asinh(v Uncertain) (result Uncertain) {
+	result.Value = math.Asinh(v.Value)
+
+	if v.Error == 0 {
+		result.Error = 0
+		return
+	}
+
+	result.Error = v.Error / math.Sqrt(1+v.Value*v.Value)
+	return
+}
+
+// Arcsinh is a synonym for Asinh
+func Arcsinh(v Uncertain) (result Uncertain) {
+	return Asinh(v)
+}
+
+// Atanh returns the inverse hyperbolic tangent of v.Value and propagates error.
+//
+// The function is unbounded at the domain edges ±1, so if the interval v.Value±v.Error
+// crosses or touches one of them the error is the larger of the first-order error
+// and the deviation to the end of the interval that stays within the domain,
+// so that it doesn't decrease when the interval reaches the edge.
+// If the interval crosses both edges, the error is +Inf.
+//
+// Special cases are:
+//
+//	Atanh({±0, e}) = {±0, e}
+//	Atanh({±1, e}) = {±Inf, +Inf}
+//	Atanh({x, e}) = {atanh(x), max(e/(1-x²), atanh(x)-atanh(x-e))} if 1-e <= x < 1
+//	Atanh({x, e}) = {atanh(x), max(e/(1-x²), atanh(x+e)-atanh(x))} if -1 < x <= -1+e
+//	Atanh({x, e}) = {NaN, _} if x < -1 or x > 1
+//	Atanh({NaN, e}) = {NaN, _}
+func Atanh(v Uncertain) (result Uncertain) {
+	result.Value = math.Atanh(v.Value)
+
+	if v.Error == 0 {
+		result.Error = 0
+		return
+	}
+
+	upper := v.Value+v.Error >= 1
+	lower := v.Value-v.Error <= -1
+
+	if upper && lower {
+		result.Error = math.Inf(1)
+		return
+	}
+
+	result.Error = v.Error / (1 - v.Value*v.Value)
+	if upper {
+		result.Error = math.Max(result.Error, result.Value-math.Atanh(v.Value-v.Error))
+	}
+	if lower {
+		result.Error = math.Max(result.Error, math.Atanh(v.Value+v.Error)-result.Value)
+	}
+	return
+}
+
+// Arctanh is a synonym for Atanh
+func Arctanh(v Uncertain) (result Uncertain) {
+	return Atanh(v)
+}
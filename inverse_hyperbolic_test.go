@@ -0,0 +1,130 @@
+package uncertain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAcosh(t *testing.T) {
+	cases := [][2]Uncertain{
+		{{0.5, 0}, {math.NaN(), 0}},
+		{{0.5, 0.1}, {math.NaN(), math.NaN()}},
+		{{math.NaN(), 0.1}, {math.NaN(), 0}},
+		{{math.Inf(1), 10}, {math.Inf(1), 0}},
+		{{1, 0}, {0, 0}},
+		{{1, 0.1}, {0, 0.443568254385115}},
+		{{1, 0.5}, {0, 0.962423650119207}},
+		{{1.05, 0.1}, {0.314924756603848, 0.314924756603848}},
+		{{1.02, 0.0199}, {0.199668157798415, 0.0990062004258939}},
+		{{1.02, 0.02}, {0.199668157798415, 0.199668157798415}},
+		{{1.5, 0}, {0.962423650119207, 0}},
+		{{1.5, 0.1}, {0.962423650119207, 0.0894427190999916}},
+		{{2, 0.1}, {1.31695789692482, 0.0577350269189626}},
+		{{2, 0.5}, {1.31695789692482, 0.288675134594813}},
+		{{10, 1}, {2.99322284612638, 0.100503781525921}},
+		{{1000, 10}, {7.60090220954199, 0.0100000050000037}},
+	}
+
+	for i, the_case := range cases {
+		res := Acosh(the_case[0])
+
+		if math.IsNaN(res.Value) {
+			if !math.IsNaN(the_case[1].Value) {
+				t.Fatalf("Test case %d failed: Acosh(%f±%f) is %f±%f, got %f±%f",
+					i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+			} else {
+				continue
+			}
+		}
+
+		if !almostEqual(res, the_case[1]) {
+			t.Fatalf("Test case %d failed: Acosh(%f±%f) is %f±%f, got %f±%f",
+				i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+		}
+	}
+}
+
+func TestAsinh(t *testing.T) {
+	negative_zero := math.Copysign(0.0, -1)
+
+	cases := [][2]Uncertain{
+		{{negative_zero, 0.1}, {negative_zero, 0.1}},
+		{{math.NaN(), 0.1}, {math.NaN(), 0}},
+		{{math.Inf(1), 10}, {math.Inf(1), 0}},
+		{{math.Inf(-1), 10}, {math.Inf(-1), 0}},
+		{{0, 0}, {0, 0}},
+		{{0, 0.1}, {0, 0.1}},
+		{{0.5, 0}, {0.481211825059603, 0}},
+		{{0.5, 0.1}, {0.481211825059603, 0.0894427190999916}},
+		{{-0.5, 0.1}, {-0.481211825059603, 0.0894427190999916}},
+		{{1, 0.2}, {0.881373587019543, 0.14142135623731}},
+		{{-1, 0.2}, {-0.881373587019543, 0.14142135623731}},
+		{{10, 1}, {2.99822295029797, 0.0995037190209989}},
+		{{-10, 1}, {-2.99822295029797, 0.0995037190209989}},
+		{{1000, 10}, {7.60090270954199, 0.00999999500000375}},
+	}
+
+	for i, the_case := range cases {
+		res := Asinh(the_case[0])
+
+		if math.IsNaN(res.Value) {
+			if !math.IsNaN(the_case[1].Value) {
+				t.Fatalf("Test case %d failed: Asinh(%f±%f) is %f±%f, got %f±%f",
+					i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+			} else {
+				continue
+			}
+		}
+
+		if !almostEqual(res, the_case[1]) {
+			t.Fatalf("Test case %d failed: Asinh(%f±%f) is %f±%f, got %f±%f",
+				i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+		}
+	}
+}
+
+func TestAtanh(t *testing.T) {
+	negative_zero := math.Copysign(0.0, -1)
+
+	cases := [][2]Uncertain{
+		{{negative_zero, 0.1}, {negative_zero, 0.1}},
+		{{math.NaN(), 0.1}, {math.NaN(), 0}},
+		{{1.5, 0}, {math.NaN(), 0}},
+		{{-1.5, 0.1}, {math.NaN(), math.NaN()}},
+		{{1, 0}, {math.Inf(1), 0}},
+		{{1, 0.1}, {math.Inf(1), math.Inf(1)}},
+		{{-1, 0.1}, {math.Inf(-1), math.Inf(1)}},
+		{{0, 1.5}, {0, math.Inf(1)}},
+		{{0, 0}, {0, 0}},
+		{{0, 0.1}, {0, 0.1}},
+		{{0.5, 0}, {0.549306144334055, 0}},
+		{{0.5, 0.1}, {0.549306144334055, 0.133333333333333}},
+		{{-0.5, 0.1}, {-0.549306144334055, 0.133333333333333}},
+		{{0.2, 0.5}, {0.202732554054082, 0.520833333333333}},
+		{{0.9, 0.05}, {1.47221948958322, 0.263157894736842}},
+		{{-0.9, 0.05}, {-1.47221948958322, 0.263157894736842}},
+		{{0.95, 0.05}, {1.83178082306482, 0.512820512820513}},
+		{{0.99, 0.02}, {2.64665241236225, 1.00502512562814}},
+		{{-0.99, 0.02}, {-2.64665241236225, 1.00502512562814}},
+		{{0.9, 0.0999}, {1.47221948958322, 0.525789473684211}},
+		{{0.9, 0.1}, {1.47221948958322, 0.526315789473684}},
+	}
+
+	for i, the_case := range cases {
+		res := Atanh(the_case[0])
+
+		if math.IsNaN(res.Value) {
+			if !math.IsNaN(the_case[1].Value) {
+				t.Fatalf("Test case %d failed: Atanh(%f±%f) is %f±%f, got %f±%f",
+					i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+			} else {
+				continue
+			}
+		}
+
+		if !almostEqual(res, the_case[1]) {
+			t.Fatalf("Test case %d failed: Atanh(%f±%f) is %f±%f, got %f±%f",
+				i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+		}
+	}
+}
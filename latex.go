@@ -0,0 +1,43 @@
+package uncertain
+
+import "strconv"
+
+// LaTeX returns v for LaTeX math mode, e.g. "1.23 \pm 0.05" or "(1.23 \pm 0.05) \times 10^{3}".
+// The error is rounded to n significant digits, or by the usual rule if n <= 0, see Format.
+//
+// The notation is 'f' for the fixed notation, 'e' for the scientific notation,
+// or 'g' to use the scientific notation only for large or small exponents, as in String.
+func (v Uncertain) LaTeX(n int, notation byte) string {
+	value, err, exp := v.split(n, notation)
+
+	s := value + ` \pm ` + err
+	if notation == 'e' || exp != 0 {
+		s = "(" + s + `) \times 10^{` + strconv.Itoa(exp) + "}"
+	}
+	return s
+}
+
+// Num returns v as a siunitx number, e.g. `\num{1.23 \pm 0.05}` or `\num{1.23 \pm 0.05 e3}`.
+// The error is rounded to n significant digits, or by the usual rule if n <= 0, see Format.
+// The notation is the same as for LaTeX.
+func (v Uncertain) Num(n int, notation byte) string {
+	return `\num{` + v.siunitx(n, notation) + "}"
+}
+
+// SI returns v with the unit as a siunitx quantity, e.g. `\SI{9.81 \pm 0.02}{\metre\per\second\squared}`.
+// The error is rounded to n significant digits, or by the usual rule if n <= 0, see Format.
+// The notation is the same as for LaTeX.
+func (v Uncertain) SI(n int, notation byte, unit string) string {
+	return `\SI{` + v.siunitx(n, notation) + "}{" + unit + "}"
+}
+
+// siunitx returns v rounded to n significant digits of the error in the input syntax of siunitx.
+func (v Uncertain) siunitx(n int, notation byte) string {
+	value, err, exp := v.split(n, notation)
+
+	s := value + ` \pm ` + err
+	if notation == 'e' || exp != 0 {
+		s += " e" + strconv.Itoa(exp)
+	}
+	return s
+}
@@ -0,0 +1,35 @@
+package uncertain
+
+import "testing"
+
+func TestLaTeX(t *testing.T) {
+	cases := []struct {
+		v        Uncertain
+		n        int
+		notation byte
+		latex    string
+		num      string
+		si       string
+	}{
+		{Uncertain{1.2345678, 0.0456}, 0, 'g', `1.23 \pm 0.05`, `\num{1.23 \pm 0.05}`, `\SI{1.23 \pm 0.05}{\metre}`},
+		{Uncertain{1.2345678, 0.0123456}, 0, 'g', `1.235 \pm 0.012`, `\num{1.235 \pm 0.012}`, `\SI{1.235 \pm 0.012}{\metre}`},
+		{Uncertain{1.2345678, 0.0123456}, 1, 'g', `1.23 \pm 0.01`, `\num{1.23 \pm 0.01}`, `\SI{1.23 \pm 0.01}{\metre}`},
+		{Uncertain{-2.5, 0.5}, 0, 'g', `-2.5 \pm 0.5`, `\num{-2.5 \pm 0.5}`, `\SI{-2.5 \pm 0.5}{\metre}`},
+		{Uncertain{1230000, 50000}, 0, 'g', `(1.23 \pm 0.05) \times 10^{6}`, `\num{1.23 \pm 0.05 e6}`, `\SI{1.23 \pm 0.05 e6}{\metre}`},
+		{Uncertain{6.62607015e-34, 8.1e-41}, 2, 'g', `(6.62607015 \pm 0.00000081) \times 10^{-34}`, `\num{6.62607015 \pm 0.00000081 e-34}`, `\SI{6.62607015 \pm 0.00000081 e-34}{\metre}`},
+		{Uncertain{3.5, 0}, 0, 'g', `3.5 \pm 0`, `\num{3.5 \pm 0}`, `\SI{3.5 \pm 0}{\metre}`},
+		{Uncertain{1230, 50}, 0, 'e', `(1.23 \pm 0.05) \times 10^{3}`, `\num{1.23 \pm 0.05 e3}`, `\SI{1.23 \pm 0.05 e3}{\metre}`},
+		{Uncertain{1.2345678, 0.0456}, 0, 'e', `(1.23 \pm 0.05) \times 10^{0}`, `\num{1.23 \pm 0.05 e0}`, `\SI{1.23 \pm 0.05 e0}{\metre}`},
+		{Uncertain{1230000, 50000}, 0, 'f', `1230000 \pm 50000`, `\num{1230000 \pm 50000}`, `\SI{1230000 \pm 50000}{\metre}`},
+		{Uncertain{0.00012, 0}, 0, 'e', `(1.2 \pm 0) \times 10^{-4}`, `\num{1.2 \pm 0 e-4}`, `\SI{1.2 \pm 0 e-4}{\metre}`},
+	}
+
+	for i, the_case := range cases {
+		latex, num, si := the_case.v.LaTeX(the_case.n, the_case.notation), the_case.v.Num(the_case.n, the_case.notation),
+			the_case.v.SI(the_case.n, the_case.notation, `\metre`)
+		if latex != the_case.latex || num != the_case.num || si != the_case.si {
+			t.Fatalf("Test case %d failed: %#v is %q, %q, %q, got %q, %q, %q",
+				i, the_case.v, the_case.latex, the_case.num, the_case.si, latex, num, si)
+		}
+	}
+}
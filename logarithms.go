@@ -1,10 +1,98 @@
 package uncertain
 
-// TODO
-//func Log(x float64) float64
+import "math"
 
-// TODO
-//func Log10(x float64) float64
+// Log returns the natural logarithm of v.Value and propagates error.
+//
+// Logarithm is unbounded near zero, so if the interval v.Value±v.Error touches or crosses zero
+// the error of the result is +Inf.
+//
+// Special cases are:
+//
+//	Log({x, 0}) = {log(x), 0}
+//	Log({+Inf, e}) = {+Inf, 0}
+//	Log({0, e}) = {-Inf, +Inf}
+//	Log({x, e}) = {log(x), +Inf} if 0 < x <= e
+//	Log({x, e}) = {NaN, _} if x < 0
+//	Log({NaN, e}) = {NaN, _}
+func Log(v Uncertain) (result Uncertain) {
+	return logarithm(v, math.Log, 0, 1)
+}
 
-// TODO
-//func Log2(x float64) float64
+// Log10 returns the decimal logarithm of v.Value and propagates error.
+//
+// Special cases are the same as for Log.
+func Log10(v Uncertain) (result Uncertain) {
+	return logarithm(v, math.Log10, 0, math.Ln10)
+}
+
+// Log2 returns the binary logarithm of v.Value and propagates error.
+//
+// Special cases are the same as for Log.
+func Log2(v Uncertain) (result Uncertain) {
+	return logarithm(v, math.Log2, 0, math.Ln2)
+}
+
+// Log1p returns the natural logarithm of 1 plus v.Value and propagates error.
+// It is more accurate than Log(1 + v) when v.Value is near zero.
+//
+// Special cases are:
+//
+//	Log1p({x, 0}) = {log(1+x), 0}
+//	Log1p({+Inf, e}) = {+Inf, 0}
+//	Log1p({-1, e}) = {-Inf, +Inf}
+//	Log1p({x, e}) = {log(1+x), +Inf} if -1 < x <= e-1
+//	Log1p({x, e}) = {NaN, _} if x < -1
+//	Log1p({NaN, e}) = {NaN, _}
+func Log1p(v Uncertain) (result Uncertain) {
+	return logarithm(v, math.Log1p, 1, 1)
+}
+
+// logarithm is a common function for Log, Log10, Log2 and Log1p.
+// The argument of the logarithm is v.Value+shift, lnBase is the natural logarithm of the base.
+func logarithm(v Uncertain, log func(float64) float64, shift, lnBase float64) (result Uncertain) {
+	result.Value = log(v.Value)
+
+	if v.Error == 0 {
+		result.Error = 0
+		return
+	}
+
+	x := v.Value + shift
+	if x-v.Error <= 0 {
+		result.Error = math.Inf(1)
+		return
+	}
+
+	result.Error = v.Error / (x * lnBase)
+	return
+}
+
+// Logb returns the binary exponent of v.Value and propagates error.
+//
+// The binary exponent is a step function, so the error is the largest deviation
+// of the binary exponent over the interval v.Value±v.Error from the result's value.
+//
+// Special cases are:
+//
+//	Logb({±Inf, e}) = {+Inf, 0}
+//	Logb({0, 0}) = {-Inf, 0}
+//	Logb({x, e}) = {logb(x), +Inf} if the interval x±e touches or crosses zero
+//	Logb({NaN, e}) = {NaN, _}
+func Logb(v Uncertain) (result Uncertain) {
+	result.Value = math.Logb(v.Value)
+
+	if v.Error == 0 || math.IsInf(v.Value, 0) {
+		result.Error = 0
+		return
+	}
+
+	lo, hi := v.Value-v.Error, v.Value+v.Error
+	if lo <= 0 && hi >= 0 {
+		result.Error = math.Inf(1)
+		return
+	}
+
+	result.Error = math.Max(math.Abs(math.Logb(lo)-result.Value), math.Abs(math.Logb(hi)-result.Value))
+	return
+}
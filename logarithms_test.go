@@ -0,0 +1,191 @@
+package uncertain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLog(t *testing.T) {
+	cases := [][2]Uncertain{
+		{{-1, 0}, {math.NaN(), 0}},
+		{{-1, 0.1}, {math.NaN(), math.Inf(1)}},
+		{{math.NaN(), 0.1}, {math.NaN(), 0}},
+		{{math.Inf(1), 10}, {math.Inf(1), 0}},
+		{{0, 0}, {math.Inf(-1), 0}},
+		{{0, 0.1}, {math.Inf(-1), math.Inf(1)}},
+		{{0.1, 0.1}, {-2.30258509299405, math.Inf(1)}},
+		{{0.1, 0.2}, {-2.30258509299405, math.Inf(1)}},
+
+		{{1, 0}, {0, 0}},
+		{{1, 0.1}, {0, 0.1}},
+		{{2, 0.1}, {0.693147180559945, 0.05}},
+		{{0.5, 0.1}, {-0.693147180559945, 0.2}},
+		{{10, 1}, {2.30258509299405, 0.1}},
+		{{100, 5}, {4.60517018598809, 0.05}},
+		{{1e-3, 1e-4}, {-6.90775527898214, 0.1}},
+		{{math.E, 0.2}, {1, 0.0735758882342885}},
+	}
+
+	for i, the_case := range cases {
+		res := Log(the_case[0])
+
+		if math.IsNaN(res.Value) {
+			if !math.IsNaN(the_case[1].Value) {
+				t.Fatalf("Test case %d failed: Log(%f±%f) is %f±%f, got %f±%f",
+					i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+			} else {
+				continue
+			}
+		}
+
+		if !almostEqual(res, the_case[1]) {
+			t.Fatalf("Test case %d failed: Log(%f±%f) is %f±%f, got %f±%f",
+				i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+		}
+	}
+}
+
+func TestLog10(t *testing.T) {
+	cases := [][2]Uncertain{
+		{{-1, 0.1}, {math.NaN(), math.Inf(1)}},
+		{{math.Inf(1), 10}, {math.Inf(1), 0}},
+		{{0, 0.1}, {math.Inf(-1), math.Inf(1)}},
+		{{0.1, 0.1}, {-1, math.Inf(1)}},
+
+		{{1, 0}, {0, 0}},
+		{{1, 0.1}, {0, 0.0434294481903252}},
+		{{2, 0.1}, {0.301029995663981, 0.0217147240951626}},
+		{{0.5, 0.1}, {-0.301029995663981, 0.0868588963806504}},
+		{{10, 1}, {1, 0.0434294481903252}},
+		{{100, 5}, {2, 0.0217147240951626}},
+		{{1e-3, 1e-4}, {-3, 0.0434294481903252}},
+		{{math.E, 0.2}, {0.434294481903252, 0.0319536022612819}},
+	}
+
+	for i, the_case := range cases {
+		res := Log10(the_case[0])
+
+		if math.IsNaN(res.Value) {
+			if !math.IsNaN(the_case[1].Value) {
+				t.Fatalf("Test case %d failed: Log10(%f±%f) is %f±%f, got %f±%f",
+					i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+			} else {
+				continue
+			}
+		}
+
+		if !almostEqual(res, the_case[1]) {
+			t.Fatalf("Test case %d failed: Log10(%f±%f) is %f±%f, got %f±%f",
+				i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+		}
+	}
+}
+
+func TestLog2(t *testing.T) {
+	cases := [][2]Uncertain{
+		{{-1, 0.1}, {math.NaN(), math.Inf(1)}},
+		{{math.Inf(1), 10}, {math.Inf(1), 0}},
+		{{0, 0.1}, {math.Inf(-1), math.Inf(1)}},
+		{{0.5, 0.5}, {-1, math.Inf(1)}},
+
+		{{1, 0}, {0, 0}},
+		{{1, 0.1}, {0, 0.144269504088896}},
+		{{2, 0.1}, {1, 0.0721347520444482}},
+		{{0.5, 0.1}, {-1, 0.288539008177793}},
+		{{10, 1}, {3.32192809488736, 0.144269504088896}},
+		{{100, 5}, {6.64385618977472, 0.0721347520444482}},
+		{{1e-3, 1e-4}, {-9.96578428466209, 0.144269504088896}},
+		{{math.E, 0.2}, {1.44269504088896, 0.106147569084609}},
+	}
+
+	for i, the_case := range cases {
+		res := Log2(the_case[0])
+
+		if math.IsNaN(res.Value) {
+			if !math.IsNaN(the_case[1].Value) {
+				t.Fatalf("Test case %d failed: Log2(%f±%f) is %f±%f, got %f±%f",
+					i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+			} else {
+				continue
+			}
+		}
+
+		if !almostEqual(res, the_case[1]) {
+			t.Fatalf("Test case %d failed: Log2(%f±%f) is %f±%f, got %f±%f",
+				i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+		}
+	}
+}
+
+func TestLog1p(t *testing.T) {
+	cases := [][2]Uncertain{
+		{{-2, 0.1}, {math.NaN(), math.Inf(1)}},
+		{{math.Inf(1), 10}, {math.Inf(1), 0}},
+		{{-1, 0}, {math.Inf(-1), 0}},
+		{{-1, 0.1}, {math.Inf(-1), math.Inf(1)}},
+		{{-0.5, 0.5}, {-0.693147180559945, math.Inf(1)}},
+
+		{{0, 0}, {0, 0}},
+		{{0, 0.1}, {0, 0.1}},
+		{{1e-10, 1e-12}, {9.9999999995e-11, 9.999999999e-13}},
+		{{1, 0.1}, {0.693147180559945, 0.05}},
+		{{-0.5, 0.1}, {-0.693147180559945, 0.2}},
+		{{10, 1}, {2.39789527279837, 0.0909090909090909}},
+	}
+
+	for i, the_case := range cases {
+		res := Log1p(the_case[0])
+
+		if math.IsNaN(res.Value) {
+			if !math.IsNaN(the_case[1].Value) {
+				t.Fatalf("Test case %d failed: Log1p(%f±%f) is %f±%f, got %f±%f",
+					i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+			} else {
+				continue
+			}
+		}
+
+		if !almostEqual(res, the_case[1]) {
+			t.Fatalf("Test case %d failed: Log1p(%f±%f) is %f±%f, got %f±%f",
+				i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+		}
+	}
+}
+
+func TestLogb(t *testing.T) {
+	cases := [][2]Uncertain{
+		{{math.NaN(), 0.1}, {math.NaN(), 0}},
+		{{math.Inf(1), 10}, {math.Inf(1), 0}},
+		{{math.Inf(-1), 10}, {math.Inf(1), 0}},
+		{{0, 0}, {math.Inf(-1), 0}},
+		{{0, 0.1}, {math.Inf(-1), math.Inf(1)}},
+		{{1, 1}, {0, math.Inf(1)}},
+		{{-1, 2}, {0, math.Inf(1)}},
+
+		{{1, 0}, {0, 0}},
+		{{10, 0}, {3, 0}},
+		{{10, 1}, {3, 0}},
+		{{8, 1}, {3, 1}},
+		{{-8, 1}, {3, 1}},
+		{{0.75, 0.5}, {-1, 1}},
+		{{1000, 100}, {9, 1}},
+	}
+
+	for i, the_case := range cases {
+		res := Logb(the_case[0])
+
+		if math.IsNaN(res.Value) {
+			if !math.IsNaN(the_case[1].Value) {
+				t.Fatalf("Test case %d failed: Logb(%f±%f) is %f±%f, got %f±%f",
+					i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+			} else {
+				continue
+			}
+		}
+
+		if !almostEqual(res, the_case[1]) {
+			t.Fatalf("Test case %d failed: Logb(%f±%f) is %f±%f, got %f±%f",
+				i, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, res.Value, res.Error)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package uncertain
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// BinarySize is the size of the binary form of an Uncertain, see MarshalBinary.
+const BinarySize = 16
+
+// MarshalText implements encoding.TextMarshaler.
+// The text form "1.2345678 ± 0.0123456" keeps full precision, unlike String.
+func (v Uncertain) MarshalText() ([]byte, error) {
+	b := strconv.AppendFloat(nil, v.Value, 'g', -1, 64)
+	b = append(b, " ± "...)
+	return strconv.AppendFloat(b, v.Error, 'g', -1, 64), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Any notation accepted by Parse is allowed.
+func (v *Uncertain) UnmarshalText(text []byte) error {
+	res, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*v = res
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+//
+// The JSON form is an object {"Value":1.23,"Error":0.05}.
+// JSON numbers can't be NaN or infinite, so such values are encoded as a string "NaN ± +Inf", see MarshalText.
+func (v Uncertain) MarshalJSON() ([]byte, error) {
+	if finite(v.Value) && finite(v.Error) {
+		return json.Marshal(struct{ Value, Error float64 }{v.Value, v.Error})
+	}
+
+	text, _ := v.MarshalText()
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// Both the object form and the string form in any notation accepted by Parse are allowed. JSON null is a no-op.
+func (v *Uncertain) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		return v.UnmarshalText([]byte(s))
+	}
+
+	var object struct{ Value, Error float64 }
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+	v.Value, v.Error = object.Value, object.Error
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+// The binary form is BinarySize bytes: IEEE 754 bits of Value and then of Error, both big-endian.
+func (v Uncertain) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 0, BinarySize)
+	b = binary.BigEndian.AppendUint64(b, math.Float64bits(v.Value))
+	b = binary.BigEndian.AppendUint64(b, math.Float64bits(v.Error))
+	return b, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, see MarshalBinary.
+func (v *Uncertain) UnmarshalBinary(data []byte) error {
+	if len(data) != BinarySize {
+		return fmt.Errorf("uncertain: binary form must be %d bytes long, got %d", BinarySize, len(data))
+	}
+	v.Value = math.Float64frombits(binary.BigEndian.Uint64(data))
+	v.Error = math.Float64frombits(binary.BigEndian.Uint64(data[8:]))
+	return nil
+}
+
+// finite reports whether x is neither NaN nor an infinity.
+func finite(x float64) bool {
+	return !math.IsNaN(x) && !math.IsInf(x, 0)
+}
@@ -0,0 +1,116 @@
+package uncertain
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+// sameUncertain reports whether u1 and u2 are equal, NaN being equal to NaN.
+func sameUncertain(u1, u2 Uncertain) bool {
+	same := func(x, y float64) bool { return x == y || math.IsNaN(x) && math.IsNaN(y) }
+	return same(u1.Value, u2.Value) && same(u1.Error, u2.Error)
+}
+
+var marshalValues = []Uncertain{
+	{1.2345678, 0.0123456},
+	{-6.62607015e-34, 8.1e-41},
+	{1e300, 0},
+	{0.1, 0.2},
+	{math.NaN(), 1},
+	{math.Inf(-1), math.Inf(1)},
+	{1, math.NaN()},
+}
+
+func TestMarshalText(t *testing.T) {
+	text, _ := Uncertain{1.2345678, 0.0123456}.MarshalText()
+	if string(text) != "1.2345678 ± 0.0123456" {
+		t.Fatalf("Text form of 1.2345678±0.0123456 is %q, got %q", "1.2345678 ± 0.0123456", text)
+	}
+
+	for i, v := range marshalValues {
+		text, err := v.MarshalText()
+		var res Uncertain
+		if err == nil {
+			err = res.UnmarshalText(text)
+		}
+		if err != nil || !sameUncertain(res, v) {
+			t.Fatalf("Test case %d failed: %#v is unmarshaled from %q as %#v (%v)", i, v, text, res, err)
+		}
+	}
+
+	var res Uncertain
+	if err := res.UnmarshalText([]byte("1.23(5)")); err != nil || res != (Uncertain{1.23, 0.05}) {
+		t.Fatalf("1.23(5) is 1.23±0.05, got %#v (%v)", res, err)
+	}
+	if err := res.UnmarshalText([]byte("1.23 ±")); err == nil {
+		t.Fatalf("Malformed text is unmarshaled without error")
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	cases := []struct {
+		v    Uncertain
+		json string
+	}{
+		{Uncertain{1.23, 0.05}, `{"Value":1.23,"Error":0.05}`},
+		{Uncertain{math.NaN(), 1}, `"NaN ± 1"`},
+		{Uncertain{1, math.Inf(1)}, `"1 ± +Inf"`},
+	}
+
+	for i, the_case := range cases {
+		data, err := json.Marshal(the_case.v)
+		if err != nil || string(data) != the_case.json {
+			t.Fatalf("Test case %d failed: JSON form of %#v is %s, got %s (%v)", i, the_case.v, the_case.json, data, err)
+		}
+	}
+
+	for i, v := range marshalValues {
+		data, err := json.Marshal(v)
+		var res Uncertain
+		if err == nil {
+			err = json.Unmarshal(data, &res)
+		}
+		if err != nil || !sameUncertain(res, v) {
+			t.Fatalf("Test case %d failed: %#v is unmarshaled from %s as %#v (%v)", i, v, data, res, err)
+		}
+	}
+
+	var record struct {
+		A, B, C Uncertain
+		D       *Uncertain
+	}
+	err := json.Unmarshal([]byte(`{"A":{"Value":1.5,"Error":0.1},"B":"1.23(5)","C":"(2 ± 0.5)e3","D":null}`), &record)
+	if err != nil || record.A != (Uncertain{1.5, 0.1}) || record.B != (Uncertain{1.23, 0.05}) || record.C != (Uncertain{2000, 500}) || record.D != nil {
+		t.Fatalf("Unmarshaled object and string forms are wrong: %+v (%v)", record, err)
+	}
+
+	for _, data := range []string{`"1.23 ±"`, `[1, 2]`, `{"Value":"1"}`} {
+		if err := json.Unmarshal([]byte(data), &record.A); err == nil {
+			t.Fatalf("%s is unmarshaled without error", data)
+		}
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	for i, v := range marshalValues {
+		data, err := v.MarshalBinary()
+		var res Uncertain
+		if err == nil {
+			err = res.UnmarshalBinary(data)
+		}
+		if err != nil || len(data) != BinarySize || !sameUncertain(res, v) {
+			t.Fatalf("Test case %d failed: %#v is unmarshaled from %x as %#v (%v)", i, v, data, res, err)
+		}
+	}
+
+	data, _ := Uncertain{1, 0.5}.MarshalBinary()
+	if string(data) != "\x3f\xf0\x00\x00\x00\x00\x00\x00\x3f\xe0\x00\x00\x00\x00\x00\x00" {
+		t.Fatalf("Binary form of 1±0.5 is 3ff00000000000003fe0000000000000, got %x", data)
+	}
+
+	var res Uncertain
+	if err := res.UnmarshalBinary(data[:15]); err == nil {
+		t.Fatalf("15 bytes are unmarshaled without error")
+	}
+}
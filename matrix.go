@@ -0,0 +1,572 @@
+package uncertain
+
+import (
+	"fmt"
+	"math"
+)
+
+// Matrix is a matrix of uncertain entries stored by rows.
+//
+// Entries are independent. Errors of Mul are propagated by the Linear rule, as for operations on Uncertain,
+// errors of MulWith are propagated by the given Propagator.
+// Det, Inverse and Solve propagate errors to first order by Jacobians with the Linear rule;
+// DetWith, InverseWith and SolveWith propagate them either to first order with the given Propagator (FirstOrder),
+// or as bounds of interval Gaussian elimination (IntervalBounds), see MatrixMethod.
+type Matrix [][]Uncertain
+
+// MatrixMethod is a method of error propagation through Det, Inverse and Solve.
+type MatrixMethod int
+
+const (
+	// FirstOrder propagates errors of entries by a Propagator with derivatives calculated by Dual numbers.
+	FirstOrder MatrixMethod = iota
+
+	// IntervalBounds calculates results in interval arithmetic, see Interval.
+	// The result is the midpoint and the half-width of a rigorous enclosure,
+	// which is wider than the first-order error, especially for large matrices. The Propagator is not used.
+	IntervalBounds
+)
+
+// ConditionError is returned by Inverse, Solve and DetWith for an ill-conditioned matrix,
+// i.e. if a matrix within errors of its entries may be singular, so that first-order errors are meaningless.
+type ConditionError struct {
+	Cond float64 // condition number of values of the matrix in 1-norm, +Inf for a singular one
+}
+
+func (e *ConditionError) Error() string {
+	if math.IsInf(e.Cond, 1) {
+		return "uncertain: singular matrix"
+	}
+	return fmt.Sprintf("uncertain: ill-conditioned matrix, condition number %g", e.Cond)
+}
+
+// NewMatrix returns a rows×cols matrix of zeros.
+func NewMatrix(rows, cols int) Matrix {
+	m := make(Matrix, rows)
+	for i := range m {
+		m[i] = make([]Uncertain, cols)
+	}
+	return m
+}
+
+// Identity returns the exact n×n identity matrix.
+func Identity(n int) Matrix {
+	m := NewMatrix(n, n)
+	for i := range m {
+		m[i][i].Value = 1
+	}
+	return m
+}
+
+// cols returns the number of columns of m.
+func (m Matrix) cols() int {
+	if len(m) == 0 {
+		return 0
+	}
+	return len(m[0])
+}
+
+// square panics if m is not square.
+func (m Matrix) square() {
+	for _, row := range m {
+		if len(row) != len(m) {
+			panic("uncertain: matrix is not square")
+		}
+	}
+}
+
+// Transpose returns the transposed m.
+func (m Matrix) Transpose() Matrix {
+	t := NewMatrix(m.cols(), len(m))
+	for i, row := range m {
+		for j, e := range row {
+			t[j][i] = e
+		}
+	}
+	return t
+}
+
+// Mul returns the product m1·m2.
+func (m1 Matrix) Mul(m2 Matrix) Matrix {
+	return m1.MulWith(Linear{}, m2)
+}
+
+// MulWith returns the product m1·m2 with errors propagated by p.
+func (m1 Matrix) MulWith(p Propagator, m2 Matrix) Matrix {
+	if m1.cols() != len(m2) {
+		panic("uncertain: matrices of incompatible sizes")
+	}
+
+	res := NewMatrix(len(m1), m2.cols())
+	for i := range res {
+		for j := range res[i] {
+			for k := range m2 {
+				res[i][j] = p.Add(res[i][j], p.Mul(m1[i][k], m2[k][j]))
+			}
+		}
+	}
+	return res
+}
+
+// Det returns the determinant of a square m with errors propagated to first order.
+func (m Matrix) Det() Uncertain {
+	det, _ := m.DetWith(Linear{}, FirstOrder)
+	return det
+}
+
+// DetWith returns the determinant of a square m with errors propagated by p and method.
+//
+// For FirstOrder the derivatives of the determinant are cofactors of entries, d(det) = tr(adj(m)·dm),
+// so that errors of the determinant of a singular matrix are calculated too.
+// For IntervalBounds the returned error is a *ConditionError if m within errors of its entries may be singular.
+func (m Matrix) DetWith(p Propagator, method MatrixMethod) (Uncertain, error) {
+	m.square()
+
+	if method == IntervalBounds {
+		_, det, ok := eliminate(m.intervals(), nil, intervalField)
+		if !ok {
+			return Uncertain{}, &ConditionError{m.cond()}
+		}
+		return det.Uncertain(), nil
+	}
+
+	lu := decompose(m.values())
+	adj := lu.adjugate()
+	contributions := make([]float64, 0, len(m)*len(m))
+	for i, row := range m {
+		for j, e := range row {
+			if e.Error != 0 {
+				contributions = append(contributions, adj[j][i]*e.Error)
+			}
+		}
+	}
+	return Uncertain{lu.det(), p.Combine(contributions...)}, nil
+}
+
+// Inverse returns the inverse of a square m with errors propagated to first order.
+// The returned error is a *ConditionError if m is singular or ill-conditioned.
+func (m Matrix) Inverse() (Matrix, error) {
+	return m.InverseWith(Linear{}, FirstOrder)
+}
+
+// InverseWith returns the inverse of a square m with errors propagated by p and method.
+// The returned error is a *ConditionError if m is singular or ill-conditioned.
+func (m Matrix) InverseWith(p Propagator, method MatrixMethod) (Matrix, error) {
+	m.square()
+	if err := m.condition(); err != nil {
+		return nil, err
+	}
+
+	inv := NewMatrix(len(m), len(m))
+
+	if method == IntervalBounds {
+		identity := Identity(len(m)).intervals()
+		x, _, ok := eliminate(m.intervals(), identity, intervalField)
+		if !ok {
+			return nil, &ConditionError{m.cond()}
+		}
+		for i := range x {
+			for j := range x[i] {
+				inv[i][j] = x[i][j].Uncertain()
+			}
+		}
+		return inv, nil
+	}
+
+	in := newVecInputs(m.flatten())
+	identity := make([][]Dual, len(m))
+	for i := range identity {
+		identity[i] = make([]Dual, len(m))
+		identity[i][i].Value = 1
+	}
+	x, _, _ := eliminate(dualRows(in.x[0], len(m)), identity, dualField)
+	for i := range x {
+		for j := range x[i] {
+			inv[i][j] = in.scalarWith(p, x[i][j])
+		}
+	}
+	return inv, nil
+}
+
+// Solve returns x such that m·x = b for a square m with errors propagated to first order.
+// The returned error is a *ConditionError if m is singular or ill-conditioned.
+func (m Matrix) Solve(b []Uncertain) ([]Uncertain, error) {
+	return m.SolveWith(Linear{}, FirstOrder, b)
+}
+
+// SolveWith returns x such that m·x = b for a square m with errors propagated by p and method.
+// The returned error is a *ConditionError if m is singular or ill-conditioned.
+func (m Matrix) SolveWith(p Propagator, method MatrixMethod, b []Uncertain) ([]Uncertain, error) {
+	m.square()
+	if len(b) != len(m) {
+		panic("uncertain: matrices of incompatible sizes")
+	}
+	if err := m.condition(); err != nil {
+		return nil, err
+	}
+
+	res := make([]Uncertain, len(b))
+
+	if method == IntervalBounds {
+		rhs := make([][]Interval, len(b))
+		for i := range b {
+			rhs[i] = []Interval{b[i].Interval()}
+		}
+		x, _, ok := eliminate(m.intervals(), rhs, intervalField)
+		if !ok {
+			return nil, &ConditionError{m.cond()}
+		}
+		for i := range x {
+			res[i] = x[i][0].Uncertain()
+		}
+		return res, nil
+	}
+
+	in := newVecInputs(m.flatten(), VecN{X: b})
+	x, _, _ := eliminate(dualRows(in.x[0], len(m)), dualRows(in.x[1], len(b)), dualField)
+	for i := range x {
+		res[i] = in.scalarWith(p, x[i][0])
+	}
+	return res, nil
+}
+
+// condition returns a *ConditionError if m is singular, or if the relative error of m
+// in 1-norm multiplied by the condition number of m is not small, so that m within errors may be singular.
+func (m Matrix) condition() error {
+	cond := m.cond()
+
+	norm, errNorm := 0.0, 0.0
+	for j := range m {
+		col, colErr := 0.0, 0.0
+		for i := range m {
+			col += math.Abs(m[i][j].Value)
+			colErr += m[i][j].Error
+		}
+		norm, errNorm = math.Max(norm, col), math.Max(errNorm, colErr)
+	}
+
+	const epsilon = 0x1p-52
+	if cond*(errNorm/norm+epsilon) >= 1 || math.IsNaN(cond) {
+		return &ConditionError{cond}
+	}
+	return nil
+}
+
+// cond returns the condition number of values of m in 1-norm, +Inf if m is singular.
+func (m Matrix) cond() float64 {
+	lu := decompose(m.values())
+	if lu.rank < len(m) {
+		return math.Inf(1)
+	}
+
+	norm, invNorm := 0.0, 0.0
+	for j := range m {
+		inv := lu.solve(j)
+		col, invCol := 0.0, 0.0
+		for i := range m {
+			col += math.Abs(m[i][j].Value)
+			invCol += math.Abs(inv[i])
+		}
+		norm, invNorm = math.Max(norm, col), math.Max(invNorm, invCol)
+	}
+	return norm * invNorm
+}
+
+// values returns values of entries of m.
+func (m Matrix) values() [][]float64 {
+	res := make([][]float64, len(m))
+	for i, row := range m {
+		res[i] = make([]float64, len(row))
+		for j, e := range row {
+			res[i][j] = e.Value
+		}
+	}
+	return res
+}
+
+// flatten returns entries of m by rows.
+func (m Matrix) flatten() VecN {
+	var v VecN
+	for _, row := range m {
+		v.X = append(v.X, row...)
+	}
+	return v
+}
+
+// intervals returns entries of m as intervals.
+func (m Matrix) intervals() [][]Interval {
+	res := make([][]Interval, len(m))
+	for i, row := range m {
+		res[i] = make([]Interval, len(row))
+		for j, e := range row {
+			res[i][j] = e.Interval()
+		}
+	}
+	return res
+}
+
+// dualRows returns flat entries as rows of a matrix with n rows.
+func dualRows(flat []Dual, n int) [][]Dual {
+	rows := make([][]Dual, n)
+	cols := len(flat) / n
+	for i := range rows {
+		rows[i] = flat[i*cols : (i+1)*cols]
+	}
+	return rows
+}
+
+// arithmetic is implemented by Dual and Interval.
+type arithmetic[T any] interface {
+	Add(T) T
+	Sub(T) T
+	Mul(T) T
+	Div(T) T
+}
+
+// field describes numbers of type T for eliminate.
+type field[T any] struct {
+	one       T
+	neg       func(T) T
+	magnitude func(T) float64 // magnitude for the choice of pivots
+	zero      func(T) bool    // pivot is zero or may be zero
+}
+
+var dualField = field[Dual]{
+	one:       Dual{Value: 1},
+	neg:       Dual.Neg,
+	magnitude: func(d Dual) float64 { return math.Abs(d.Value) },
+	zero:      func(d Dual) bool { return d.Value == 0 },
+}
+
+var intervalField = field[Interval]{
+	one:       Interval{1, 1},
+	neg:       func(i Interval) Interval { return Interval{-i.Hi, -i.Lo} },
+	magnitude: func(i Interval) float64 { return math.Abs(i.Lo/2 + i.Hi/2) },
+	zero:      func(i Interval) bool { return i.Contains(0) || i.IsEmpty() },
+}
+
+// eliminate solves a·x = b by Gaussian elimination with partial pivoting and returns x and the determinant of a.
+// Arguments are not modified. If b is nil, only the determinant is calculated.
+// If a pivot is zero or may be zero, ok is false.
+func eliminate[T arithmetic[T]](a, b [][]T, f field[T]) (x [][]T, det T, ok bool) {
+	n := len(a)
+	a = cloneRows(a)
+	b = cloneRows(b)
+	det = f.one
+
+	for col := range n {
+		p := col
+		for r := col + 1; r < n; r++ {
+			if f.magnitude(a[r][col]) > f.magnitude(a[p][col]) {
+				p = r
+			}
+		}
+
+		if f.zero(a[p][col]) {
+			var zero T
+			return nil, zero, false
+		}
+
+		if p != col {
+			a[p], a[col] = a[col], a[p]
+			if b != nil {
+				b[p], b[col] = b[col], b[p]
+			}
+			det = f.neg(det)
+		}
+		det = det.Mul(a[col][col])
+
+		for r := col + 1; r < n; r++ {
+			k := a[r][col].Div(a[col][col])
+			for c := col + 1; c < n; c++ {
+				a[r][c] = a[r][c].Sub(k.Mul(a[col][c]))
+			}
+			if b == nil {
+				continue
+			}
+			for c := range b[r] {
+				b[r][c] = b[r][c].Sub(k.Mul(b[col][c]))
+			}
+		}
+	}
+
+	if b == nil {
+		return nil, det, true
+	}
+
+	x = make([][]T, n)
+	for i := n - 1; i >= 0; i-- {
+		x[i] = make([]T, len(b[i]))
+		for c := range b[i] {
+			sum := b[i][c]
+			for k := i + 1; k < n; k++ {
+				sum = sum.Sub(a[i][k].Mul(x[k][c]))
+			}
+			x[i][c] = sum.Div(a[i][i])
+		}
+	}
+	return x, det, true
+}
+
+// luDecomposition is the LU decomposition of a square matrix a with full pivoting, P·a·Q = L·U,
+// which reveals the rank of a: pivots after the first rank ones are zero.
+type luDecomposition struct {
+	lu   [][]float64 // L below the diagonal without its unit diagonal, U on and above it
+	rows []int       // row i of P·a is row rows[i] of a
+	cols []int       // column j of a·Q is column cols[j] of a
+	sign float64     // determinant of P·Q
+	rank int
+}
+
+// decompose returns the LU decomposition of a square a with full pivoting. The argument is not modified.
+func decompose(a [][]float64) (d luDecomposition) {
+	n := len(a)
+	d.lu = cloneRows(a)
+	d.rows, d.cols = make([]int, n), make([]int, n)
+	for i := range n {
+		d.rows[i], d.cols[i] = i, i
+	}
+	d.sign = 1
+
+	for k := range n {
+		pr, pc := k, k
+		for r := k; r < n; r++ {
+			for c := k; c < n; c++ {
+				if math.Abs(d.lu[r][c]) > math.Abs(d.lu[pr][pc]) {
+					pr, pc = r, c
+				}
+			}
+		}
+		if d.lu[pr][pc] == 0 {
+			return
+		}
+		d.rank++
+
+		if pr != k {
+			d.lu[pr], d.lu[k] = d.lu[k], d.lu[pr]
+			d.rows[pr], d.rows[k] = d.rows[k], d.rows[pr]
+			d.sign = -d.sign
+		}
+		if pc != k {
+			for _, row := range d.lu {
+				row[pc], row[k] = row[k], row[pc]
+			}
+			d.cols[pc], d.cols[k] = d.cols[k], d.cols[pc]
+			d.sign = -d.sign
+		}
+
+		for r := k + 1; r < n; r++ {
+			l := d.lu[r][k] / d.lu[k][k]
+			d.lu[r][k] = l
+			for c := k + 1; c < n; c++ {
+				d.lu[r][c] -= l * d.lu[k][c]
+			}
+		}
+	}
+	return
+}
+
+// det returns the determinant of the decomposed matrix.
+func (d luDecomposition) det() float64 {
+	if d.rank < len(d.lu) {
+		return 0
+	}
+	det := d.sign
+	for k := range d.lu {
+		det *= d.lu[k][k]
+	}
+	return det
+}
+
+// adjugate returns the adjugate of the decomposed matrix a, the transposed matrix of cofactors.
+//
+// For a nonsingular a it is det(a)·a⁻¹. For a of rank n - 1 it is c·x·yᵀ, where x and y are null vectors
+// of a and aᵀ, and c is the product of non-zero pivots. For a of a lower rank it is zero.
+func (d luDecomposition) adjugate() [][]float64 {
+	n := len(d.lu)
+	adj := make([][]float64, n)
+	for i := range adj {
+		adj[i] = make([]float64, n)
+	}
+
+	switch {
+	case d.rank == n:
+		det := d.det()
+		for j := range n {
+			x := d.solve(j)
+			for i := range n {
+				adj[i][j] = det * x[i]
+			}
+		}
+
+	case d.rank == n-1:
+		c := d.sign
+		for k := range n - 1 {
+			c *= d.lu[k][k]
+		}
+
+		// U·w = 0 with w[n-1] = 1, x = Q·w
+		w := make([]float64, n)
+		w[n-1] = 1
+		for k := n - 2; k >= 0; k-- {
+			sum := 0.0
+			for j := k + 1; j < n; j++ {
+				sum += d.lu[k][j] * w[j]
+			}
+			w[k] = -sum / d.lu[k][k]
+		}
+		// Lᵀ·z = e[n-1], y = Pᵀ·z
+		z := make([]float64, n)
+		z[n-1] = 1
+		for k := n - 2; k >= 0; k-- {
+			sum := 0.0
+			for r := k + 1; r < n; r++ {
+				sum += d.lu[r][k] * z[r]
+			}
+			z[k] = -sum
+		}
+
+		for i := range n {
+			for j := range n {
+				adj[d.cols[i]][d.rows[j]] = c * w[i] * z[j]
+			}
+		}
+	}
+	return adj
+}
+
+// solve returns x such that a·x is the j-th unit vector, i.e. the j-th column of the inverse of the decomposed nonsingular a.
+func (d luDecomposition) solve(j int) []float64 {
+	n := len(d.lu)
+	y := make([]float64, n)
+	for k := range n {
+		if d.rows[k] == j {
+			y[k] = 1
+		}
+		for c := range k {
+			y[k] -= d.lu[k][c] * y[c]
+		}
+	}
+
+	x := make([]float64, n)
+	for k := n - 1; k >= 0; k-- {
+		sum := y[k]
+		for c := k + 1; c < n; c++ {
+			sum -= d.lu[k][c] * x[d.cols[c]]
+		}
+		x[d.cols[k]] = sum / d.lu[k][k]
+	}
+	return x
+}
+
+// cloneRows returns a copy of rows, nil for nil.
+func cloneRows[T any](rows [][]T) [][]T {
+	if rows == nil {
+		return nil
+	}
+	res := make([][]T, len(rows))
+	for i, row := range rows {
+		res[i] = append([]T(nil), row...)
+	}
+	return res
+}
@@ -0,0 +1,199 @@
+package uncertain
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMatrixMul(t *testing.T) {
+	a := Matrix{{{1, 0.1}, {2, 0}}, {{3, 0}, {4, 0.2}}}
+
+	if tr := a.Transpose(); tr[0][1] != (Uncertain{3, 0}) || tr[1][0] != (Uncertain{2, 0}) || tr[1][1] != (Uncertain{4, 0.2}) {
+		t.Fatalf("Transposed matrix is wrong: %v", tr)
+	}
+
+	p := a.Mul(Matrix{{{1, 0}}, {{1, 0.5}}})
+	if len(p) != 2 || len(p[0]) != 1 || !almostEqual(p[0][0], Uncertain{3, 1.1}) || !almostEqual(p[1][0], Uncertain{7, 2.2}) {
+		t.Fatalf("Product is (3±1.1, 7±2.2), got %v", p)
+	}
+
+	if p := a.Mul(Identity(2)); p[0][0] != a[0][0] || p[1][1] != a[1][1] {
+		t.Fatalf("Product by identity is wrong: %v", p)
+	}
+}
+
+func TestMatrixDet(t *testing.T) {
+	cases := []struct {
+		name string
+		m    Matrix
+		det  Uncertain
+	}{
+		{"2×2", Matrix{{{2, 0.1}, {1, 0.1}}, {{1, 0.1}, {3, 0.1}}}, Uncertain{5, 0.7}},
+		{"exact", Matrix{{{2, 0}, {1, 0}, {0, 0}}, {{1, 0}, {3, 0}, {1, 0}}, {{0, 0}, {1, 0}, {4, 0}}}, Uncertain{18, 0}},
+		{"singular", Matrix{{{1, 0.1}, {2, 0}}, {{2, 0}, {4, 0}}}, Uncertain{0, 0.4}},
+		{"zero column", Matrix{{{0, 0.1}, {2, 0}}, {{0, 0.2}, {4, 0}}}, Uncertain{0, 0.8}},
+	}
+
+	for i, the_case := range cases {
+		if det := the_case.m.Det(); !almostEqual(det, the_case.det) {
+			t.Fatalf("Test case %d failed: determinant of %s matrix is %v, got %v", i, the_case.name, the_case.det, det)
+		}
+
+		var condErr *ConditionError
+		bounds, err := the_case.m.DetWith(Linear{}, IntervalBounds)
+		if the_case.name == "singular" || the_case.name == "zero column" {
+			if !errors.As(err, &condErr) {
+				t.Fatalf("Test case %d failed: bounds of determinant of %s matrix must be reported, got %v", i, the_case.name, bounds)
+			}
+			continue
+		}
+		if err != nil || !bounds.Interval().Contains(the_case.det.Value) || bounds.Error < the_case.det.Error*(1-1e-9) {
+			t.Fatalf("Test case %d failed: bounds of determinant of %s matrix must enclose %v, got %v (%v)", i, the_case.name, the_case.det, bounds, err)
+		}
+	}
+}
+
+func TestMatrixDetSingular(t *testing.T) {
+	// Rank 2, derivatives of the determinant are cofactors
+	m := Matrix{{{1, 0.1}, {2, 0.1}, {3, 0.1}}, {{2, 0.1}, {4, 0.1}, {6, 0.1}}, {{1, 0.1}, {0, 0.1}, {1, 0.1}}}
+	args := m.flatten().X
+	det := Eval(func(x ...Dual) Dual {
+		minor := func(a, b, c, d int) Dual { return x[a].Mul(x[d]).Sub(x[b].Mul(x[c])) }
+		return x[0].Mul(minor(4, 5, 7, 8)).Sub(x[1].Mul(minor(3, 5, 6, 8))).Add(x[2].Mul(minor(3, 4, 6, 7)))
+	}, args...)
+
+	if res := m.Det(); !almostEqual(res, det) {
+		t.Fatalf("Determinant of singular matrix is %v, got %v", det, res)
+	}
+
+	// Rank 1, all cofactors are zero
+	m = Matrix{{{1, 0.1}, {2, 0.1}, {3, 0.1}}, {{2, 0.1}, {4, 0.1}, {6, 0.1}}, {{3, 0.1}, {6, 0.1}, {9, 0.1}}}
+	if res := m.Det(); res != (Uncertain{0, 0}) {
+		t.Fatalf("Determinant of matrix of rank 1 is 0±0, got %v", res)
+	}
+
+	// Polynomial time for large singular matrices
+	m = NewMatrix(100, 100)
+	for i := range m {
+		m[i][i] = Uncertain{1, 0.1}
+	}
+	m[0][0] = Uncertain{0, 0.1}
+	if res := m.Det(); res != (Uncertain{0, 0.1}) {
+		t.Fatalf("Determinant of 100×100 singular diagonal matrix is 0±0.1, got %v", res)
+	}
+	if res := NewMatrix(100, 100).Det(); res != (Uncertain{0, 0}) {
+		t.Fatalf("Determinant of 100×100 zero matrix is 0±0, got %v", res)
+	}
+}
+
+func TestMatrixInverse(t *testing.T) {
+	inv, err := Matrix{{{2, 0.1}}}.Inverse()
+	if err != nil || !almostEqual(inv[0][0], Uncertain{0.5, 0.025}) {
+		t.Fatalf("Inverse of 2±0.1 is 0.5±0.025, got %v (%v)", inv, err)
+	}
+
+	a := Matrix{{{4, 0}, {7, 0}}, {{2, 0}, {6, 0}}}
+	inv, err = a.Inverse()
+	res := Matrix{{{0.6, 0}, {-0.7, 0}}, {{-0.2, 0}, {0.4, 0}}}
+	for i := range res {
+		for j := range res {
+			if err != nil || math.Abs(inv[i][j].Value-res[i][j].Value) > 1e-15 || inv[i][j].Error != 0 {
+				t.Fatalf("Inverse of exact matrix is %v, got %v (%v)", res, inv, err)
+			}
+		}
+	}
+
+	b := Matrix{{{4, 0.01}, {7, 0.02}}, {{2, 0.01}, {6, 0.01}}}
+	inv, err = b.Inverse()
+	bounds, boundsErr := b.InverseWith(Linear{}, IntervalBounds)
+	for i := range inv {
+		for j := range inv {
+			if err != nil || boundsErr != nil || !bounds[i][j].Interval().Contains(inv[i][j].Value) || bounds[i][j].Error < inv[i][j].Error {
+				t.Fatalf("Bounds of inverse %v must enclose %v (%v, %v)", bounds, inv, err, boundsErr)
+			}
+		}
+	}
+}
+
+func TestMatrixSolve(t *testing.T) {
+	a := Matrix{{{2, 0.02}, {1, 0.01}}, {{1, 0.01}, {3, 0.03}}}
+	b := []Uncertain{{3, 0.1}, {5, 0.1}}
+
+	// Cramer's rule
+	cramer := func(i int) func(x ...Dual) Dual {
+		return func(x ...Dual) Dual {
+			det := x[0].Mul(x[3]).Sub(x[1].Mul(x[2]))
+			if i == 0 {
+				return x[4].Mul(x[3]).Sub(x[1].Mul(x[5])).Div(det)
+			}
+			return x[0].Mul(x[5]).Sub(x[4].Mul(x[2])).Div(det)
+		}
+	}
+	args := []Uncertain{a[0][0], a[0][1], a[1][0], a[1][1], b[0], b[1]}
+
+	x, err := a.Solve(b)
+	if err != nil || !almostEqual(x[0], Eval(cramer(0), args...)) || !almostEqual(x[1], Eval(cramer(1), args...)) {
+		t.Fatalf("Solution is (%v, %v) by Cramer's rule, got %v (%v)", Eval(cramer(0), args...), Eval(cramer(1), args...), x, err)
+	}
+
+	bounds, err := a.SolveWith(Linear{}, IntervalBounds, b)
+	for i := range x {
+		if err != nil || !bounds[i].Interval().Contains(x[i].Value) || bounds[i].Error < x[i].Error {
+			t.Fatalf("Bounds of solution %v must enclose %v (%v)", bounds, x, err)
+		}
+	}
+}
+
+func TestMatrixConditioning(t *testing.T) {
+	var condErr *ConditionError
+
+	_, err := Matrix{{{1, 0}, {2, 0}}, {{2, 0}, {4, 0}}}.Inverse()
+	if !errors.As(err, &condErr) || !math.IsInf(condErr.Cond, 1) || err.Error() != "uncertain: singular matrix" {
+		t.Fatalf("Singular matrix must be reported, got %v", err)
+	}
+
+	illConditioned := Matrix{{{1, 0.01}, {1, 0}}, {{1, 0}, {1.001, 0}}}
+	_, err = illConditioned.Solve([]Uncertain{{1, 0}, {2, 0}})
+	if !errors.As(err, &condErr) || condErr.Cond < 1000 {
+		t.Fatalf("Ill-conditioned matrix must be reported, got %v", err)
+	}
+	if _, err = illConditioned.InverseWith(Linear{}, IntervalBounds); err == nil {
+		t.Fatalf("Ill-conditioned matrix must be reported for interval bounds")
+	}
+
+	exact := Matrix{{{1, 0}, {1, 0}}, {{1, 0}, {1.001, 0}}}
+	if _, err = exact.Solve([]Uncertain{{1, 0}, {2, 0}}); err != nil {
+		t.Fatalf("Exact matrix with condition number 4000 is solved, got %v", err)
+	}
+}
+
+func TestMatrixPropagator(t *testing.T) {
+	var q Quadrature
+
+	a := Matrix{{{1, 0.1}, {2, 0}}, {{3, 0}, {4, 0.2}}}
+	p := a.MulWith(q, Matrix{{{1, 0}}, {{1, 0.5}}})
+	if !almostEqual(p[0][0], Uncertain{3, math.Sqrt(1.01)}) || !almostEqual(p[1][0], Uncertain{7, math.Sqrt(4.04)}) {
+		t.Fatalf("Product in quadrature is (3±%f, 7±%f), got %v", math.Sqrt(1.01), math.Sqrt(4.04), p)
+	}
+
+	m := Matrix{{{2, 0.1}, {1, 0.1}}, {{1, 0.1}, {3, 0.1}}}
+	if det, err := m.DetWith(q, FirstOrder); err != nil || !almostEqual(det, Uncertain{5, math.Sqrt(0.15)}) {
+		t.Fatalf("Determinant in quadrature is 5±%f, got %v (%v)", math.Sqrt(0.15), det, err)
+	}
+
+	b := []Uncertain{{3, 0.1}, {5, 0.1}}
+	args := []Uncertain{m[0][0], m[0][1], m[1][0], m[1][1], b[0], b[1]}
+	cramer := func(x ...Dual) Dual {
+		det := x[0].Mul(x[3]).Sub(x[1].Mul(x[2]))
+		return x[4].Mul(x[3]).Sub(x[1].Mul(x[5])).Div(det)
+	}
+	if x, err := m.SolveWith(q, FirstOrder, b); err != nil || !almostEqual(x[0], EvalWith(q, cramer, args...)) {
+		t.Fatalf("Solution in quadrature is %v, got %v (%v)", EvalWith(q, cramer, args...), x, err)
+	}
+
+	inverse := func(x ...Dual) Dual { return x[3].Div(x[0].Mul(x[3]).Sub(x[1].Mul(x[2]))) }
+	if inv, err := m.InverseWith(q, FirstOrder); err != nil || !almostEqual(inv[0][0], EvalWith(q, inverse, args[:4]...)) {
+		t.Fatalf("Inverse in quadrature is %v, got %v (%v)", EvalWith(q, inverse, args[:4]...), inv, err)
+	}
+}
@@ -0,0 +1,141 @@
+package uncertain
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+)
+
+// Distribution defines how samples of an uncertain input are drawn from its value and error.
+type Distribution int
+
+const (
+	// Normal distribution with mean Value and standard deviation Error.
+	Normal Distribution = iota
+	// Uniform distribution over the interval Value±Error.
+	Uniform
+)
+
+// DefaultMonteCarloSamples is the number of samples drawn by MonteCarlo if N is zero.
+const DefaultMonteCarloSamples = 100000
+
+// MonteCarlo propagates errors through any function by evaluating it on random samples of the inputs.
+// It serves as a reference for strongly non-linear functions, where first-order rules are unreliable.
+//
+// The random number generator is seeded with Seed, so results are reproducible.
+type MonteCarlo struct {
+	N            int // Number of samples. DefaultMonteCarloSamples is used if N is zero.
+	Seed         uint64
+	Distribution Distribution // Distribution of inputs, unless it is given in Distributions.
+
+	// Distributions of inputs by index, e.g. to mix normal and rectangular inputs, as in GUM Supplement 1.
+	// Distribution is used for inputs beyond its length.
+	Distributions []Distribution
+}
+
+// MonteCarloResult is a set of sorted function values calculated by MonteCarlo.
+type MonteCarloResult struct {
+	samples []float64
+}
+
+// Run evaluates f on N random samples of inputs.
+//
+// The slice passed to f is reused between calls, f must not retain it.
+func (mc MonteCarlo) Run(f func(x []float64) float64, inputs []Uncertain) MonteCarloResult {
+	n := mc.N
+	if n <= 0 {
+		n = DefaultMonteCarloSamples
+	}
+
+	rng := rand.New(rand.NewPCG(mc.Seed, mc.Seed))
+
+	x := make([]float64, len(inputs))
+	samples := make([]float64, n)
+
+	for i := range samples {
+		for j, in := range inputs {
+			x[j] = mc.draw(rng, in, j)
+		}
+		samples[i] = f(x)
+	}
+	slices.Sort(samples)
+
+	return MonteCarloResult{samples}
+}
+
+// draw returns a random sample of v, the i-th input.
+func (mc MonteCarlo) draw(rng *rand.Rand, v Uncertain, i int) float64 {
+	dist := mc.Distribution
+	if i < len(mc.Distributions) {
+		dist = mc.Distributions[i]
+	}
+
+	switch dist {
+	case Uniform:
+		return v.Value + (2*rng.Float64()-1)*v.Error
+	default:
+		return v.Value + rng.NormFloat64()*v.Error
+	}
+}
+
+// Uncertain returns the mean of the samples as a value and their standard deviation as an error.
+// If f returned NaN for any sample, both are NaN.
+func (r MonteCarloResult) Uncertain() (result Uncertain) {
+	n := float64(len(r.samples))
+	if n == 0 {
+		return Uncertain{math.NaN(), math.NaN()}
+	}
+
+	sum := 0.0
+	for _, s := range r.samples {
+		sum += s
+	}
+	result.Value = sum / n
+
+	if n == 1 {
+		return
+	}
+
+	sum = 0
+	for _, s := range r.samples {
+		d := s - result.Value
+		sum += d * d
+	}
+	result.Error = math.Sqrt(sum / (n - 1))
+	return
+}
+
+// Percentile returns the p-th quantile of the samples, 0 <= p <= 1, linearly interpolated between the nearest samples.
+//
+// Special cases are:
+//
+//	Percentile(p) = NaN if p < 0 or p > 1 or there are no samples
+//	Percentile(p) = NaN if f returned NaN for any sample
+func (r MonteCarloResult) Percentile(p float64) float64 {
+	n := len(r.samples)
+	// NaN samples are sorted first
+	if n == 0 || !(p >= 0 && p <= 1) || math.IsNaN(r.samples[0]) {
+		return math.NaN()
+	}
+
+	pos := p * float64(n-1)
+	i := int(pos)
+	if i >= n-1 {
+		return r.samples[n-1]
+	}
+
+	frac := pos - float64(i)
+	return r.samples[i] + frac*(r.samples[i+1]-r.samples[i])
+}
+
+// Coverage returns the probabilistically symmetric coverage interval [lo, hi]
+// containing the fraction p of the samples, e.g. p = 0.95 for the 95% interval.
+// Both bounds are NaN if f returned NaN for any sample, see Percentile.
+func (r MonteCarloResult) Coverage(p float64) (lo, hi float64) {
+	return r.Percentile((1 - p) / 2), r.Percentile((1 + p) / 2)
+}
+
+// Samples returns the sorted function values. The slice must not be modified.
+func (r MonteCarloResult) Samples() []float64 {
+	return r.samples
+}
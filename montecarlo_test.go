@@ -0,0 +1,115 @@
+package uncertain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMonteCarloLinear(t *testing.T) {
+	sum := func(x []float64) float64 { return x[0] + x[1] }
+
+	mc := MonteCarlo{Seed: 1}
+	res := mc.Run(sum, []Uncertain{{10, 3}, {-5, 4}}).Uncertain()
+
+	if math.Abs(res.Value-5) > 0.05 || math.Abs(res.Error-5) > 0.05 {
+		t.Fatalf("Sum of 10±3 and -5±4 is 5±5, got %f±%f", res.Value, res.Error)
+	}
+}
+
+func TestMonteCarloUniform(t *testing.T) {
+	identity := func(x []float64) float64 { return x[0] }
+
+	mc := MonteCarlo{N: 200000, Seed: 2, Distribution: Uniform}
+	run := mc.Run(identity, []Uncertain{{1, 0.5}})
+	res := run.Uncertain()
+
+	if math.Abs(res.Value-1) > 0.005 || math.Abs(res.Error-0.5/math.Sqrt(3)) > 0.005 {
+		t.Fatalf("Uniform 1±0.5 has mean 1 and standard deviation %f, got %f±%f", 0.5/math.Sqrt(3), res.Value, res.Error)
+	}
+
+	samples := run.Samples()
+	if samples[0] < 0.5 || samples[len(samples)-1] > 1.5 {
+		t.Fatalf("Uniform samples of 1±0.5 must be within [0.5, 1.5], got [%f, %f]", samples[0], samples[len(samples)-1])
+	}
+
+	lo, hi := run.Coverage(0.9)
+	if math.Abs(lo-0.55) > 0.005 || math.Abs(hi-1.45) > 0.005 {
+		t.Fatalf("90%% coverage interval of uniform 1±0.5 is [0.55, 1.45], got [%f, %f]", lo, hi)
+	}
+}
+
+func TestMonteCarloReproducible(t *testing.T) {
+	f := func(x []float64) float64 { return math.Tan(x[0]) }
+	inputs := []Uncertain{{1, 0.1}}
+
+	r1 := MonteCarlo{N: 1000, Seed: 42}.Run(f, inputs).Uncertain()
+	r2 := MonteCarlo{N: 1000, Seed: 42}.Run(f, inputs).Uncertain()
+	r3 := MonteCarlo{N: 1000, Seed: 43}.Run(f, inputs).Uncertain()
+
+	if r1 != r2 {
+		t.Fatalf("Same seed must give the same result, got %f±%f and %f±%f", r1.Value, r1.Error, r2.Value, r2.Error)
+	}
+	if r1 == r3 {
+		t.Fatalf("Different seeds must give different results, got %f±%f twice", r1.Value, r1.Error)
+	}
+}
+
+func TestMonteCarloNonLinear(t *testing.T) {
+	// Cos has zero derivative at 0, but a non-zero spread and a bias of the mean.
+	f := func(x []float64) float64 { return math.Cos(x[0]) }
+
+	run := MonteCarlo{Seed: 3}.Run(f, []Uncertain{{0, 0.1}})
+	res := run.Uncertain()
+
+	if math.Abs(res.Value-0.995) > 0.0005 || math.Abs(res.Error-0.1*0.1/math.Sqrt(2)) > 0.0005 {
+		t.Fatalf("Cos(0±0.1) is 0.995±0.007071, got %f±%f", res.Value, res.Error)
+	}
+	if run.Percentile(1) > 1 {
+		t.Fatalf("Cos can't exceed 1, got %f", run.Percentile(1))
+	}
+}
+
+func TestMonteCarloPercentile(t *testing.T) {
+	r := MonteCarloResult{[]float64{1, 2, 3, 4, 5}}
+
+	cases := [][2]float64{
+		{0, 1}, {0.25, 2}, {0.5, 3}, {0.625, 3.5}, {1, 5}, {-0.1, math.NaN()}, {1.1, math.NaN()}, {math.NaN(), math.NaN()},
+	}
+
+	for i, the_case := range cases {
+		res := r.Percentile(the_case[0])
+
+		if math.IsNaN(the_case[1]) {
+			if !math.IsNaN(res) {
+				t.Fatalf("Test case %d failed: Percentile(%f) is NaN, got %f", i, the_case[0], res)
+			}
+			continue
+		}
+		if res != the_case[1] {
+			t.Fatalf("Test case %d failed: Percentile(%f) is %f, got %f", i, the_case[0], the_case[1], res)
+		}
+	}
+
+	if res := (MonteCarloResult{}).Uncertain(); !math.IsNaN(res.Value) {
+		t.Fatalf("Result without samples is NaN, got %f±%f", res.Value, res.Error)
+	}
+
+	withNaN := MonteCarlo{N: 1000, Seed: 1}.Run(func(x []float64) float64 { return math.Sqrt(x[0]) }, []Uncertain{{0, 1}})
+	if lo, hi := withNaN.Coverage(0.5); !math.IsNaN(lo) || !math.IsNaN(hi) || !math.IsNaN(withNaN.Percentile(1)) {
+		t.Fatalf("Percentiles of results with NaN samples are NaN, got [%f, %f]", lo, hi)
+	}
+}
+
+func TestMonteCarloDistributions(t *testing.T) {
+	mixed := MonteCarlo{N: 100000, Seed: 3, Distribution: Uniform, Distributions: []Distribution{Normal}}
+
+	samples := mixed.Run(func(x []float64) float64 { return x[1] }, []Uncertain{{0, 1}, {1, 0.5}}).Samples()
+	if samples[0] < 0.5 || samples[len(samples)-1] > 1.5 {
+		t.Fatalf("Second input is uniform within [0.5, 1.5], got [%f, %f]", samples[0], samples[len(samples)-1])
+	}
+
+	res := mixed.Run(func(x []float64) float64 { return x[0] }, []Uncertain{{0, 1}, {1, 0.5}})
+	if lo, hi := res.Coverage(0.95); math.Abs(lo+1.96) > 0.03 || math.Abs(hi-1.96) > 0.03 {
+		t.Fatalf("95%% coverage interval of normal 0±1 is [-1.96, 1.96], got [%f, %f]", lo, hi)
+	}
+}
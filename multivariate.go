@@ -0,0 +1,151 @@
+package uncertain
+
+import "math"
+
+// CovarianceSet is a set of uncertain quantities with their mean values and covariance matrix,
+// i.e. errors are standard deviations and correlations between quantities are known.
+type CovarianceSet struct {
+	Mean []float64
+	Cov  [][]float64
+}
+
+// NewCovarianceSet returns a set of values with standard deviations equal to their errors
+// and the given matrix of correlation coefficients. If correlation is nil, values are independent.
+func NewCovarianceSet(values []Uncertain, correlation [][]float64) CovarianceSet {
+	s := CovarianceSet{make([]float64, len(values)), make([][]float64, len(values))}
+	for i, v := range values {
+		s.Mean[i] = v.Value
+		s.Cov[i] = make([]float64, len(values))
+		for j, w := range values {
+			switch {
+			case correlation != nil:
+				s.Cov[i][j] = correlation[i][j] * v.Error * w.Error
+			case i == j:
+				s.Cov[i][j] = v.Error * v.Error
+			}
+		}
+	}
+	return s
+}
+
+// Uncertain returns the i-th quantity with its standard deviation as an error.
+func (s CovarianceSet) Uncertain(i int) Uncertain {
+	return Uncertain{s.Mean[i], math.Sqrt(s.Cov[i][i])}
+}
+
+// Uncertainties returns all quantities with their standard deviations as errors.
+func (s CovarianceSet) Uncertainties() []Uncertain {
+	res := make([]Uncertain, len(s.Mean))
+	for i := range res {
+		res[i] = s.Uncertain(i)
+	}
+	return res
+}
+
+// Correlation returns the matrix of correlation coefficients.
+// Correlation coefficients of an exact quantity are zero, except for itself.
+func (s CovarianceSet) Correlation() [][]float64 {
+	res := make([][]float64, len(s.Cov))
+	for i := range res {
+		res[i] = make([]float64, len(s.Cov))
+		for j := range res {
+			switch {
+			case i == j:
+				res[i][j] = 1
+			case s.Cov[i][i] != 0 && s.Cov[j][j] != 0:
+				res[i][j] = s.Cov[i][j] / math.Sqrt(s.Cov[i][i]*s.Cov[j][j])
+			}
+		}
+	}
+	return res
+}
+
+// VecN returns the quantities as a vector with covariance.
+func (s CovarianceSet) VecN() VecN {
+	return VecN{s.Uncertainties(), s.Cov}
+}
+
+// Multivariate is a vector function of a vector argument for propagation of a covariance matrix.
+//
+// The function is given either as F, then its Jacobian is calculated by numeric differentiation,
+// or as D in Dual numbers, then the Jacobian is exact. If both are set, D is used.
+type Multivariate struct {
+	F func(x []float64) []float64
+	D func(x ...Dual) []Dual
+}
+
+// Propagate returns means and covariance of the function's values at means of in,
+// propagated to first order as J·Cov·Jᵀ, where J is the Jacobian of the function, as in the GUM.
+func (m Multivariate) Propagate(in CovarianceSet) CovarianceSet {
+	mean, jacobian := m.Jacobian(in.Mean, in.Cov)
+
+	out := CovarianceSet{mean, make([][]float64, len(mean))}
+	for i := range mean {
+		out.Cov[i] = make([]float64, len(mean))
+		for j := range mean {
+			for k := range in.Mean {
+				for l := range in.Mean {
+					if in.Cov[k][l] != 0 {
+						out.Cov[i][j] += jacobian[i][k] * in.Cov[k][l] * jacobian[j][l]
+					}
+				}
+			}
+		}
+	}
+	return out
+}
+
+// Jacobian returns values of the function at x and its Jacobian: derivatives of i-th value by j-th argument.
+// Steps of numeric differentiation are proportional to |x| or to standard deviations if they are larger.
+// Cov is optional, if it is not nil, numeric derivatives by arguments with zero variance are not calculated and are zero.
+func (m Multivariate) Jacobian(x []float64, cov [][]float64) (values []float64, jacobian [][]float64) {
+	if m.D != nil {
+		args := make([]Dual, len(x))
+		for i := range x {
+			args[i] = Dual{x[i], make([]float64, len(x))}
+			args[i].Grad[i] = 1
+		}
+
+		res := m.D(args...)
+		values = make([]float64, len(res))
+		jacobian = make([][]float64, len(res))
+		for i, d := range res {
+			values[i] = d.Value
+			jacobian[i] = make([]float64, len(x))
+			copy(jacobian[i], d.Grad)
+		}
+		return
+	}
+
+	values = m.F(x)
+	jacobian = make([][]float64, len(values))
+	for i := range jacobian {
+		jacobian[i] = make([]float64, len(x))
+	}
+
+	arg := append([]float64(nil), x...)
+	for j := range x {
+		scale := math.Abs(x[j])
+		if cov != nil {
+			if cov[j][j] == 0 {
+				continue
+			}
+			scale = math.Max(scale, math.Sqrt(cov[j][j]))
+		} else if scale == 0 {
+			scale = 1
+		}
+		// The optimal step of the central difference is about cbrt(epsilon) of the scale of an argument
+		h := 6e-6 * scale
+
+		arg[j] = x[j] + h
+		plus := m.F(arg)
+		arg[j] = x[j] - h
+		minus := m.F(arg)
+		arg[j] = x[j]
+
+		for i := range values {
+			jacobian[i][j] = (plus[i] - minus[i]) / (2 * h)
+		}
+	}
+	return
+}
@@ -0,0 +1,74 @@
+package uncertain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMultivariatePolar(t *testing.T) {
+	in := NewCovarianceSet([]Uncertain{{2, 0.1}, {math.Pi / 3, 0.1}}, nil)
+
+	numeric := Multivariate{F: func(x []float64) []float64 {
+		return []float64{x[0] * math.Cos(x[1]), x[0] * math.Sin(x[1])}
+	}}
+	automatic := Multivariate{D: func(x ...Dual) []Dual {
+		return []Dual{x[0].Mul(x[1].Cos()), x[0].Mul(x[1].Sin())}
+	}}
+
+	ref := NewComplexPolar(in.Uncertain(0), in.Uncertain(1))
+
+	for i, m := range []Multivariate{numeric, automatic} {
+		out := m.Propagate(in)
+
+		if math.Abs(out.Mean[0]-1) > 1e-15 || math.Abs(out.Mean[1]-math.Sqrt(3)) > 1e-15 {
+			t.Fatalf("Test case %d failed: means are (1, 1.732), got %v", i, out.Mean)
+		}
+		for r := range 2 {
+			for c := range 2 {
+				if math.Abs(out.Cov[r][c]-ref.Cov[r][c]) > 1e-9*math.Abs(ref.Cov[r][r]) {
+					t.Fatalf("Test case %d failed: covariance is %v, got %v", i, ref.Cov, out.Cov)
+				}
+			}
+		}
+
+		rho := out.Correlation()
+		if rho[0][0] != 1 || rho[1][1] != 1 || math.Abs(rho[0][1]-ref.Correlation()) > 1e-9 || math.Abs(rho[0][1]-rho[1][0]) > 1e-9 {
+			t.Fatalf("Test case %d failed: correlation is %f, got %v", i, ref.Correlation(), rho)
+		}
+		if x := out.Uncertain(0); math.Abs(x.Error-ref.Real().Error) > 1e-9 {
+			t.Fatalf("Test case %d failed: x is %v, got %v", i, ref.Real(), x)
+		}
+	}
+}
+
+func TestMultivariateCorrelated(t *testing.T) {
+	values := []Uncertain{{10, 1}, {10, 1}, {5, 0}}
+	in := NewCovarianceSet(values, [][]float64{{1, 1, 0}, {1, 1, 0}, {0, 0, 1}})
+
+	if rho := in.Correlation(); rho[0][1] != 1 || rho[0][2] != 0 || rho[2][2] != 1 {
+		t.Fatalf("Correlation of input is wrong: %v", rho)
+	}
+	if u := in.Uncertainties(); u[0] != values[0] || u[2] != values[2] {
+		t.Fatalf("Uncertainties of input are wrong: %v", u)
+	}
+
+	// Difference and sum of fully correlated values, product with an exact one
+	f := Multivariate{F: func(x []float64) []float64 { return []float64{x[0] - x[1], x[0] + x[1], x[0] * x[2]} }}
+	out := f.Propagate(in)
+
+	res := []Uncertain{{0, 0}, {20, 2}, {50, 5}}
+	for i, r := range res {
+		if u := out.Uncertain(i); math.Abs(u.Value-r.Value) > 1e-12 || math.Abs(u.Error-r.Error) > 1e-8 {
+			t.Fatalf("Output %d is %v, got %v", i, r, u)
+		}
+	}
+
+	if v := out.VecN(); len(v.X) != 3 || v.Cov[1][2] != out.Cov[1][2] {
+		t.Fatalf("Vector of output is wrong: %v", v)
+	}
+
+	zero, jacobian := f.Jacobian([]float64{0, 0, 0}, nil)
+	if zero[2] != 0 || math.Abs(jacobian[0][0]-1) > 1e-9 || math.Abs(jacobian[0][1]+1) > 1e-9 || jacobian[2][0] != 0 {
+		t.Fatalf("Jacobian at zero is wrong: %v", jacobian)
+	}
+}
@@ -0,0 +1,176 @@
+package uncertain
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParseError describes a string which can't be parsed as an Uncertain.
+type ParseError struct {
+	Input string // the input string
+	Msg   string // description of the problem
+}
+
+func (e *ParseError) Error() string {
+	return "uncertain: parsing " + strconv.Quote(e.Input) + ": " + e.Msg
+}
+
+// parseError returns a *ParseError for input s with the formatted message.
+func parseError(s string, format string, args ...any) error {
+	return &ParseError{s, fmt.Sprintf(format, args...)}
+}
+
+// Parse parses s as an Uncertain. Accepted notations are:
+//
+//	"1.23 ± 0.05", "1.23+/-0.05", "1.23 +- 0.05" - value and absolute error
+//	"1.23 ± 4%" - value and relative error in percent
+//	"(1.23 ± 0.05)e3" - value and error with a common exponent
+//	"1.23(5)", "6.62607015(81)e-34" - concise notation, see ParseConcise
+//	"1.23" - exact value
+//
+// The returned error is a *ParseError.
+func Parse(s string) (Uncertain, error) {
+	t := strings.TrimSpace(s)
+
+	switch {
+	case t == "":
+		return Uncertain{}, parseError(s, "empty string")
+	case t[0] == '(':
+		closing := strings.LastIndex(t, ")")
+		if closing < 0 {
+			return Uncertain{}, parseError(s, "missing closing parenthesis")
+		}
+		exp, err := parseExponent(s, t[closing+1:])
+		if err != nil {
+			return Uncertain{}, err
+		}
+		return parsePlusMinus(s, t[1:closing], exp)
+	case strings.Contains(t, "("):
+		return ParseConcise(s)
+	}
+
+	return parsePlusMinus(s, t, 0)
+}
+
+// separators are the accepted separators of a value and its error.
+var separators = []string{"±", "+/-", "+-"}
+
+// parsePlusMinus parses t in the "value ± error" form, with both numbers multiplied by 10**exp.
+// Input s is used for error messages.
+func parsePlusMinus(s, t string, exp int) (Uncertain, error) {
+	value, err, found := t, "", false
+	for _, separator := range separators {
+		if value, err, found = strings.Cut(t, separator); found {
+			break
+		}
+	}
+	value, err = strings.TrimSpace(value), strings.TrimSpace(err)
+
+	if value == "" {
+		return Uncertain{}, parseError(s, "missing value")
+	}
+	v, parseErr := parseScaled(value, exp)
+	if parseErr != nil {
+		return Uncertain{}, parseError(s, "invalid value %q", value)
+	}
+	if !found {
+		return Uncertain{v, 0}, nil
+	}
+
+	var e float64
+	if percent, ok := strings.CutSuffix(err, "%"); ok {
+		p, parseErr := strconv.ParseFloat(strings.TrimSpace(percent), 64)
+		if parseErr != nil {
+			return Uncertain{}, parseError(s, "invalid relative error %q", err)
+		}
+		e = math.Abs(v) * p / 100
+	} else {
+		if err == "" {
+			return Uncertain{}, parseError(s, "missing error after separator")
+		}
+		if e, parseErr = parseScaled(err, exp); parseErr != nil {
+			return Uncertain{}, parseError(s, "invalid error %q", err)
+		}
+	}
+
+	if e < 0 {
+		return Uncertain{}, parseError(s, "negative error %q", err)
+	}
+	return Uncertain{v, e}, nil
+}
+
+// parseExponent parses the exponent suffix "e3" or "E-34", or an empty suffix as zero exponent.
+// Input s is used for error messages.
+func parseExponent(s, suffix string) (int, error) {
+	if suffix == "" {
+		return 0, nil
+	}
+	if suffix[0] != 'e' && suffix[0] != 'E' {
+		return 0, parseError(s, "unexpected %q after closing parenthesis", suffix)
+	}
+	exp, err := strconv.Atoi(suffix[1:])
+	if err != nil {
+		return 0, parseError(s, "invalid exponent %q", suffix)
+	}
+	return exp, nil
+}
+
+// ParseConcise parses s in the concise notation, e.g. "1.2345(12)" or "6.62607015(81)e-34",
+// where the digits in parentheses are the error in units of the last digit of the value.
+// The error with a decimal point, e.g. "1.2345(0.0012)", is the absolute error.
+//
+// ParseConcise is the inverse of Concise. The returned error is a *ParseError.
+func ParseConcise(s string) (Uncertain, error) {
+	value, rest, ok := strings.Cut(strings.TrimSpace(s), "(")
+	if !ok {
+		return Uncertain{}, parseError(s, "missing opening parenthesis")
+	}
+	err, suffix, ok := strings.Cut(rest, ")")
+	if !ok {
+		return Uncertain{}, parseError(s, "missing closing parenthesis")
+	}
+	if value == "" {
+		return Uncertain{}, parseError(s, "missing value")
+	}
+	if strings.ContainsAny(value, "eE") {
+		return Uncertain{}, parseError(s, "exponent must follow the parentheses")
+	}
+	if err == "" {
+		return Uncertain{}, parseError(s, "missing error in parentheses")
+	}
+	if err[0] == '-' {
+		return Uncertain{}, parseError(s, "negative error %q", err)
+	}
+
+	exp, parseErr := parseExponent(s, suffix)
+	if parseErr != nil {
+		return Uncertain{}, parseErr
+	}
+
+	decimals := 0
+	if _, fraction, ok := strings.Cut(value, "."); ok && !strings.Contains(err, ".") {
+		decimals = len(fraction)
+	}
+
+	v, parseErr := parseScaled(value, exp)
+	if parseErr != nil {
+		return Uncertain{}, parseError(s, "invalid value %q", value)
+	}
+	e, parseErr := parseScaled(err, exp-decimals)
+	if parseErr != nil {
+		return Uncertain{}, parseError(s, "invalid error %q", err)
+	}
+
+	return Uncertain{v, e}, nil
+}
+
+// parseScaled returns the decimal number s multiplied by 10**exp without loss of precision.
+func parseScaled(s string, exp int) (float64, error) {
+	x, err := strconv.ParseFloat(s, 64)
+	if err != nil || exp == 0 || math.IsNaN(x) || math.IsInf(x, 0) {
+		return x, err
+	}
+	return strconv.ParseFloat(s+"e"+strconv.Itoa(exp), 64)
+}
@@ -0,0 +1,118 @@
+package uncertain
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestParseConcise(t *testing.T) {
+	cases := []struct {
+		s   string
+		res Uncertain
+	}{
+		{"1.2345(12)", Uncertain{1.2345, 0.0012}},
+		{" 1.23(5) ", Uncertain{1.23, 0.05}},
+		{"-2.5(5)", Uncertain{-2.5, 0.5}},
+		{"12.3(12)", Uncertain{12.3, 1.2}},
+		{"12300(1200)", Uncertain{12300, 1200}},
+		{"1.2345(0.0012)", Uncertain{1.2345, 0.0012}},
+		{"6.62607015(81)e-34", Uncertain{6.62607015e-34, 8.1e-41}},
+		{"1.234567(12)E+06", Uncertain{1234567, 12}},
+		{"3.5(0)", Uncertain{3.5, 0}},
+	}
+
+	for i, the_case := range cases {
+		res, err := ParseConcise(the_case.s)
+		if err != nil || res != the_case.res {
+			t.Fatalf("Test case %d failed: %q is %f±%f, got %f±%f (%v)",
+				i, the_case.s, the_case.res.Value, the_case.res.Error, res.Value, res.Error, err)
+		}
+	}
+
+	for _, s := range []string{"", "1.23", "1.23(5", "(5)", "1.23(-5)", "1.23()", "1.2e3(5)", "1.23(5)x", "1.23(5)e", "a(5)"} {
+		if _, err := ParseConcise(s); err == nil {
+			t.Fatalf("%q is not in concise notation, no error returned", s)
+		}
+	}
+}
+
+func TestConciseRoundTrip(t *testing.T) {
+	for _, s := range []string{"1.2345(12)", "6.62607015(81)e-34", "1.602176634(0)e-19", "9.1093837139(28)e-31", "12300(1200)", "-0.00123(45)", "2.99792458(0)e+08"} {
+		v, err := ParseConcise(s)
+		if err != nil {
+			t.Fatalf("Can't parse %q: %v", s, err)
+		}
+		if res := v.Concise(len(s[strings.Index(s, "(")+1 : strings.Index(s, ")")])); res != s {
+			t.Fatalf("%q is formatted as %q", s, res)
+		}
+	}
+
+	for _, v := range []Uncertain{{1.2345678, 0.0123456}, {6.62607015e-34, 8.1e-42}, {-98765.4321, 0.36}, {0.5, 0.0097}} {
+		res, err := ParseConcise(v.Concise(0))
+		if err != nil || res.String() != v.String() || math.Abs(res.Value-v.Value) > res.Error {
+			t.Fatalf("%v is parsed back as %v (%v)", v, res, err)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		s   string
+		res Uncertain
+	}{
+		{"1.23 ± 0.05", Uncertain{1.23, 0.05}},
+		{"1.23±0.05", Uncertain{1.23, 0.05}},
+		{"1.23+/-0.05", Uncertain{1.23, 0.05}},
+		{" -1.23 +- 0.05 ", Uncertain{-1.23, 0.05}},
+		{"1.23e3 ± 5e1", Uncertain{1230, 50}},
+		{"1.23(5)", Uncertain{1.23, 0.05}},
+		{"6.62607015(81)e-34", Uncertain{6.62607015e-34, 8.1e-41}},
+		{"(1.23 ± 0.05)e3", Uncertain{1230, 50}},
+		{"(6.62607015 +/- 0.00000081)E-34", Uncertain{6.62607015e-34, 8.1e-41}},
+		{"(1.23 ± 0.05)", Uncertain{1.23, 0.05}},
+		{"1.25 ± 4%", Uncertain{1.25, 0.05}},
+		{"-1.25 ± 4 %", Uncertain{-1.25, 0.05}},
+		{"(1.25 ± 4%)e3", Uncertain{1250, 50}},
+		{"1.23", Uncertain{1.23, 0}},
+	}
+
+	for i, the_case := range cases {
+		res, err := Parse(the_case.s)
+		if err != nil || !almostEqual(res, the_case.res) {
+			t.Fatalf("Test case %d failed: %q is %g±%g, got %g±%g (%v)",
+				i, the_case.s, the_case.res.Value, the_case.res.Error, res.Value, res.Error, err)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := []struct {
+		s   string
+		msg string
+	}{
+		{"", `uncertain: parsing "": empty string`},
+		{"  ", `uncertain: parsing "  ": empty string`},
+		{"abc", `uncertain: parsing "abc": invalid value "abc"`},
+		{"± 0.05", `uncertain: parsing "± 0.05": missing value`},
+		{"1.23 ±", `uncertain: parsing "1.23 ±": missing error after separator`},
+		{"1.23 ± x", `uncertain: parsing "1.23 ± x": invalid error "x"`},
+		{"1.23 ± -0.05", `uncertain: parsing "1.23 ± -0.05": negative error "-0.05"`},
+		{"1.23 ± x%", `uncertain: parsing "1.23 ± x%": invalid relative error "x%"`},
+		{"(1.23 ± 0.05", `uncertain: parsing "(1.23 ± 0.05": missing closing parenthesis`},
+		{"(1.23 ± 0.05)x3", `uncertain: parsing "(1.23 ± 0.05)x3": unexpected "x3" after closing parenthesis`},
+		{"(1.23 ± 0.05)e", `uncertain: parsing "(1.23 ± 0.05)e": invalid exponent "e"`},
+		{"1.23(5", `uncertain: parsing "1.23(5": missing closing parenthesis`},
+		{"1.23()", `uncertain: parsing "1.23()": missing error in parentheses`},
+		{"1.23(-5)", `uncertain: parsing "1.23(-5)": negative error "-5"`},
+		{"1.2e3(5)", `uncertain: parsing "1.2e3(5)": exponent must follow the parentheses`},
+		{"1.23(x)", `uncertain: parsing "1.23(x)": invalid error "x"`},
+	}
+
+	for i, the_case := range cases {
+		_, err := Parse(the_case.s)
+		if _, ok := err.(*ParseError); !ok || err.Error() != the_case.msg {
+			t.Fatalf("Test case %d failed: error for %q is %q, got %v", i, the_case.s, the_case.msg, err)
+		}
+	}
+}
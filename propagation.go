@@ -0,0 +1,158 @@
+package uncertain
+
+import "math"
+
+// Propagator defines how error contributions of independent operands are combined into the error of a result.
+//
+// Functions of a single argument have only one error contribution,
+// so they give the same result for any Propagator and are not a part of the interface.
+type Propagator interface {
+	// Combine returns the error of a result from error contributions of the operands.
+	Combine(contributions ...float64) float64
+
+	Add(v1, v2 Uncertain) Uncertain
+	Sub(v1, v2 Uncertain) Uncertain
+	Mul(v1, v2 Uncertain) Uncertain
+	Div(v1, v2 Uncertain) Uncertain
+	Pow(x, y Uncertain) Uncertain
+	Atan2(y, x Uncertain) Uncertain
+}
+
+// Linear is the worst-case Propagator: error of a result is a sum of absolute values of error contributions.
+//
+// This is the default rule used by methods and functions of Uncertain, so Linear{}.Add(v1, v2) is the same as v1.Add(v2).
+type Linear struct{}
+
+// Combine returns the sum of absolute values of contributions.
+func (Linear) Combine(contributions ...float64) (sum float64) {
+	for _, c := range contributions {
+		sum += math.Abs(c)
+	}
+	return
+}
+
+// Add is the same as v1.Add(v2).
+func (Linear) Add(v1, v2 Uncertain) Uncertain {
+	return v1.Add(v2)
+}
+
+// Sub is the same as v1.Sub(v2).
+func (Linear) Sub(v1, v2 Uncertain) Uncertain {
+	return v1.Sub(v2)
+}
+
+// Mul is the same as v1.Mul(v2).
+func (Linear) Mul(v1, v2 Uncertain) Uncertain {
+	return v1.Mul(v2)
+}
+
+// Div is the same as v1.Div(v2).
+func (Linear) Div(v1, v2 Uncertain) Uncertain {
+	return v1.Div(v2)
+}
+
+// Pow is the same as function Pow.
+func (Linear) Pow(x, y Uncertain) Uncertain {
+	return Pow(x, y)
+}
+
+// Atan2 is the same as function Atan2.
+func (Linear) Atan2(y, x Uncertain) Uncertain {
+	return Atan2(y, x)
+}
+
+// Quadrature is the Propagator for independent random errors:
+// error of a result is a root sum of squares of error contributions, as recommended by the GUM.
+type Quadrature struct{}
+
+// Combine returns the root sum of squares of contributions.
+func (Quadrature) Combine(contributions ...float64) (sum float64) {
+	for _, c := range contributions {
+		sum = math.Hypot(sum, c)
+	}
+	return
+}
+
+// Add returns the sum of v1 and v2. Absolute error is a root sum of squares of absolute errors.
+func (q Quadrature) Add(v1, v2 Uncertain) (sum Uncertain) {
+	sum.Value = v1.Value + v2.Value
+	sum.Error = q.Combine(v1.Error, v2.Error)
+	return
+}
+
+// Sub returns the difference between v1 and v2. Absolute error is a root sum of squares of absolute errors.
+func (q Quadrature) Sub(v1, v2 Uncertain) (diff Uncertain) {
+	diff.Value = v1.Value - v2.Value
+	diff.Error = q.Combine(v1.Error, v2.Error)
+	return
+}
+
+// Mul returns the product of v1 and v2. Relative error is a root sum of squares of relative errors.
+//
+// Special cases are:
+//
+//	v1.Value = 0
+//	v2.Value = 0
+//	- impossible to calculate relative error if the value is zero.
+//	  Product of errors is added as a third contribution, so the error is not lost.
+func (q Quadrature) Mul(v1, v2 Uncertain) (product Uncertain) {
+	product.Value = v1.Value * v2.Value
+
+	c1 := v2.Value * v1.Error
+	c2 := v1.Value * v2.Error
+
+	if product.Value == 0 {
+		product.Error = q.Combine(c1, c2, v1.Error*v2.Error)
+		return
+	}
+
+	product.Error = q.Combine(c1, c2)
+	return
+}
+
+// Div returns the quotient of dividend v1 and divisor v2. Relative error is a root sum of squares of relative errors.
+//
+// Special cases are:
+//
+//	v1.Value = 0 - impossible to calculate relative error if the value is zero. v1 is multiplied by reciprocal of v2.
+//	v2.Value = 0 - if the divisor value is 0, both value and error of the result are Inf.
+func (q Quadrature) Div(v1, v2 Uncertain) (quotient Uncertain) {
+	if v1.Value == 0 {
+		var reciprocal Uncertain
+		reciprocal.Value = 1 / v2.Value
+		reciprocal.Error = v2.Error / (v2.Value * v2.Value)
+		return q.Mul(v1, reciprocal)
+	}
+
+	quotient.Value = v1.Value / v2.Value
+	quotient.Error = q.Combine(v1.Error/v1.Value, v2.Error/v2.Value) * math.Abs(quotient.Value)
+	return
+}
+
+// Pow returns x**y and propagates errors of both the base and the exponent.
+//
+// Special cases are the same as for function Pow.
+func (q Quadrature) Pow(x, y Uncertain) (result Uncertain) {
+	if x.Value == 0 {
+		return Pow(x, y)
+	}
+
+	result.Value = math.Pow(x.Value, y.Value)
+	result.Error = q.Combine(powErrors(x, y, result.Value))
+	return
+}
+
+// Atan2 returns the arc tangent of y/x and propagates error.
+//
+// Special cases are the same as for function Atan2. If both y.Value and x.Value are zero
+// the derivatives are undefined and the result of function Atan2 is returned.
+func (q Quadrature) Atan2(y, x Uncertain) (result Uncertain) {
+	r2 := x.Value*x.Value + y.Value*y.Value
+	if r2 == 0 || math.IsInf(r2, 1) {
+		return Atan2(y, x)
+	}
+
+	result.Value = math.Atan2(y.Value, x.Value)
+	result.Error = q.Combine(x.Value*y.Error/r2, y.Value*x.Error/r2)
+	return
+}
@@ -0,0 +1,125 @@
+package uncertain
+
+import (
+	"math"
+	"testing"
+)
+
+func checkBinary(t *testing.T, name string, f func(v1, v2 Uncertain) Uncertain, cases [][3]Uncertain) {
+	t.Helper()
+
+	for i, the_case := range cases {
+		res := f(the_case[0], the_case[1])
+
+		if !almostEqual(res, the_case[2]) {
+			t.Fatalf("Test case %d failed: %s(%f±%f, %f±%f) is %f±%f, got %f±%f",
+				i, name, the_case[0].Value, the_case[0].Error, the_case[1].Value, the_case[1].Error, the_case[2].Value, the_case[2].Error, res.Value, res.Error)
+		}
+	}
+}
+
+func TestLinearCombine(t *testing.T) {
+	if c := (Linear{}).Combine(); c != 0 {
+		t.Fatalf("Linear combination of nothing is 0, got %f", c)
+	}
+	if c := (Linear{}).Combine(1, -2, 0.5); c != 3.5 {
+		t.Fatalf("Linear combination of 1, -2, 0.5 is 3.5, got %f", c)
+	}
+}
+
+func TestLinearIsDefault(t *testing.T) {
+	values := []Uncertain{
+		{0, 0}, {0, 1}, {10, 1}, {-2, 0.1}, {100, 5}, {-200, 40}, {3, 0.5},
+	}
+
+	var p Propagator = Linear{}
+
+	for _, v1 := range values {
+		for _, v2 := range values {
+			if p.Add(v1, v2) != v1.Add(v2) || p.Sub(v1, v2) != v1.Sub(v2) || p.Mul(v1, v2) != v1.Mul(v2) {
+				t.Fatalf("Linear differs from default for %f±%f and %f±%f", v1.Value, v1.Error, v2.Value, v2.Error)
+			}
+			if v2.Value != 0 && p.Div(v1, v2) != v1.Div(v2) {
+				t.Fatalf("Linear.Div differs from default for %f±%f and %f±%f", v1.Value, v1.Error, v2.Value, v2.Error)
+			}
+			if p.Atan2(v1, v2) != Atan2(v1, v2) {
+				t.Fatalf("Linear.Atan2 differs from default for %f±%f and %f±%f", v1.Value, v1.Error, v2.Value, v2.Error)
+			}
+		}
+	}
+}
+
+func TestQuadratureCombine(t *testing.T) {
+	if c := (Quadrature{}).Combine(); c != 0 {
+		t.Fatalf("Quadrature combination of nothing is 0, got %f", c)
+	}
+	if c := (Quadrature{}).Combine(3, -4); c != 5 {
+		t.Fatalf("Quadrature combination of 3, -4 is 5, got %f", c)
+	}
+	if c := (Quadrature{}).Combine(1e300, 1e300); math.IsInf(c, 0) {
+		t.Fatalf("Quadrature combination must not overflow, got %f", c)
+	}
+}
+
+func TestQuadratureAdd(t *testing.T) {
+	checkBinary(t, "Quadrature.Add", Quadrature{}.Add, [][3]Uncertain{
+		{{0, 0}, {0, 0}, {0, 0}},
+		{{3, 0}, {0, 3}, {3, 3}},
+		{{1, 0.1}, {1, 0.1}, {2, 0.14142135623731}},
+		{{10, 3}, {-5, 4}, {5, 5}},
+	})
+}
+
+func TestQuadratureSub(t *testing.T) {
+	checkBinary(t, "Quadrature.Sub", Quadrature{}.Sub, [][3]Uncertain{
+		{{0, 0}, {0, 0}, {0, 0}},
+		{{3, 0}, {0, 3}, {3, 3}},
+		{{1, 0.1}, {1, 0.1}, {0, 0.14142135623731}},
+		{{10, 3}, {-5, 4}, {15, 5}},
+	})
+}
+
+func TestQuadratureMul(t *testing.T) {
+	checkBinary(t, "Quadrature.Mul", Quadrature{}.Mul, [][3]Uncertain{
+		{{7, 0}, {6, 0}, {42, 0}},
+		{{0, 1}, {10, 1}, {0, 10.0498756211209}},
+		{{10, 1}, {0, 1}, {0, 10.0498756211209}},
+		{{0, 0.5}, {0, 0.2}, {0, 0.1}},
+		{{10, 1}, {5, 0.5}, {50, 7.07106781186548}},
+		{{-2, 0.1}, {2, 0.1}, {-4, 0.282842712474619}},
+		{{100, 5}, {-200, 40}, {-20000, 4123.10562561766}},
+	})
+}
+
+func TestQuadratureDiv(t *testing.T) {
+	checkBinary(t, "Quadrature.Div", Quadrature{}.Div, [][3]Uncertain{
+		{{0, 10}, {1, 0}, {0, 10}},
+		{{0, 10}, {1, 0.1}, {0, 10.0498756211209}},
+		{{10, 1}, {10, 1}, {1, 0.14142135623731}},
+		{{500, 25}, {-10, 5}, {-50, 25.1246890528022}},
+		{{-300, 20}, {3, 0.5}, {-100, 17.950549357115}},
+		{{10, 3}, {0, 4}, {math.Inf(1), math.Inf(1)}},
+	})
+}
+
+func TestQuadraturePow(t *testing.T) {
+	checkBinary(t, "Quadrature.Pow", Quadrature{}.Pow, [][3]Uncertain{
+		{{0, 0.01}, {2, 0}, {0, 0.0001}},
+		{{0, 0.1}, {0, 0}, {1, 0}},
+		{{2, 0.1}, {3, 0}, {8, 1.2}},
+		{{2, 0.1}, {3, 0.1}, {8, 1.32192659739777}},
+		{{10, 1}, {2, 0.05}, {100, 23.0769896815412}},
+	})
+}
+
+func TestQuadratureAtan2(t *testing.T) {
+	checkBinary(t, "Quadrature.Atan2", Quadrature{}.Atan2, [][3]Uncertain{
+		{{math.Inf(1), 10}, {100, 10}, {math.Pi / 2, 0}},
+		{{0, 0}, {10, 1}, {0, 0}},
+		{{0, 1}, {10, 1}, {0, 0.1}},
+		{{300, 10}, {300, 10}, {math.Pi / 4, 0.0235702260395516}},
+		{{500, 50}, {-500, 0}, {3 * math.Pi / 4, 0.05}},
+		{{-200, 30}, {200, 30}, {-math.Pi / 4, 0.106066017177982}},
+		{{300, 30}, {300 * math.Sqrt(3), 70}, {math.Pi / 6, 0.0726483157256779}},
+	})
+}
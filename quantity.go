@@ -0,0 +1,348 @@
+package uncertain
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Dimension is a physical dimension, i.e. exponents of SI base dimensions indexed by Length, Mass, etc.
+//
+// Plane angle is dimensionless in SI, but it is kept as a separate dimension Angle,
+// so that an angle in degrees is not confused with a ratio.
+type Dimension [8]int8
+
+// Indices of base dimensions in Dimension.
+const (
+	Length = iota
+	Mass
+	Time
+	Current
+	Temperature
+	Amount
+	Luminosity
+	Angle
+)
+
+// baseSymbols are symbols of SI units of base dimensions, in order of indices.
+var baseSymbols = [len(Dimension{})]string{"m", "kg", "s", "A", "K", "mol", "cd", "rad"}
+
+// String returns the dimension in SI base units, e.g. "m·kg·s^-2", or "1" for a dimensionless one.
+func (d Dimension) String() string {
+	var parts []string
+	for i, exp := range d {
+		switch exp {
+		case 0:
+		case 1:
+			parts = append(parts, baseSymbols[i])
+		default:
+			parts = append(parts, baseSymbols[i]+"^"+strconv.Itoa(int(exp)))
+		}
+	}
+	if parts == nil {
+		return "1"
+	}
+	return strings.Join(parts, "·")
+}
+
+// IsDimensionless reports whether all exponents of d are zero.
+func (d Dimension) IsDimensionless() bool {
+	return d == Dimension{}
+}
+
+// Mul returns the dimension of a product.
+func (d1 Dimension) Mul(d2 Dimension) (d Dimension) {
+	for i := range d {
+		d[i] = d1[i] + d2[i]
+	}
+	return
+}
+
+// Div returns the dimension of a quotient.
+func (d1 Dimension) Div(d2 Dimension) (d Dimension) {
+	for i := range d {
+		d[i] = d1[i] - d2[i]
+	}
+	return
+}
+
+// pow returns the dimension of d**y, ok is false if some exponent of the result is not an integer.
+func (d Dimension) pow(y float64) (res Dimension, ok bool) {
+	for i, exp := range d {
+		e := float64(exp) * y
+		if e != math.Trunc(e) || math.Abs(e) > math.MaxInt8 {
+			return Dimension{}, false
+		}
+		res[i] = int8(e)
+	}
+	return res, true
+}
+
+// Unit is a unit of measurement: a dimension and the value of the unit in SI units of that dimension.
+//
+// Units of absolute temperature like degree Celsius also have an offset: x °C is x·Scale + Offset kelvins.
+// The offset is used only by Convert and ToSI, all other operations treat
+// such quantities as temperature differences.
+type Unit struct {
+	Name   string    // symbol of the unit, e.g. "mm"
+	Dim    Dimension // dimension of the unit
+	Scale  float64   // value of the unit in SI units, e.g. 0.001 for millimetre
+	Offset float64   // zero of the unit in SI units, e.g. 273.15 for degree Celsius
+}
+
+// Units of base dimensions and a few common units.
+var (
+	One      = Unit{Name: "", Dim: Dimension{}, Scale: 1}
+	Metre    = Unit{Name: "m", Dim: Dimension{Length: 1}, Scale: 1}
+	Kilogram = Unit{Name: "kg", Dim: Dimension{Mass: 1}, Scale: 1}
+	Second   = Unit{Name: "s", Dim: Dimension{Time: 1}, Scale: 1}
+	Ampere   = Unit{Name: "A", Dim: Dimension{Current: 1}, Scale: 1}
+	Kelvin   = Unit{Name: "K", Dim: Dimension{Temperature: 1}, Scale: 1}
+	Mole     = Unit{Name: "mol", Dim: Dimension{Amount: 1}, Scale: 1}
+	Candela  = Unit{Name: "cd", Dim: Dimension{Luminosity: 1}, Scale: 1}
+	Radian   = Unit{Name: "rad", Dim: Dimension{Angle: 1}, Scale: 1}
+
+	Millimetre = Unit{Name: "mm", Dim: Dimension{Length: 1}, Scale: 1e-3}
+	Inch       = Unit{Name: "in", Dim: Dimension{Length: 1}, Scale: 0.0254}
+	Degree     = Unit{Name: "°", Dim: Dimension{Angle: 1}, Scale: math.Pi / 180}
+)
+
+// Mul returns the unit of a product, e.g. "N·m".
+func (u1 Unit) Mul(u2 Unit) Unit {
+	name := u1.Name + "·" + u2.Name
+	if u1.Name == "" || u2.Name == "" {
+		name = u1.Name + u2.Name
+	}
+	return Unit{Name: name, Dim: u1.Dim.Mul(u2.Dim), Scale: u1.Scale * u2.Scale}
+}
+
+// Div returns the unit of a quotient, e.g. "m/s".
+func (u1 Unit) Div(u2 Unit) Unit {
+	name := u1.Name
+	if u2.Name != "" {
+		if name == "" {
+			name = "1"
+		}
+		name += "/" + parenthesize(u2.Name)
+	}
+	return Unit{Name: name, Dim: u1.Dim.Div(u2.Dim), Scale: u1.Scale / u2.Scale}
+}
+
+// pow returns the unit u**y, ok is false if the dimension of the result has non-integer exponents.
+func (u Unit) pow(y float64) (res Unit, ok bool) {
+	dim, ok := u.Dim.pow(y)
+	if !ok {
+		return Unit{}, false
+	}
+
+	name := u.Name
+	if name != "" && y != 1 {
+		name = parenthesize(name) + "^" + strconv.FormatFloat(y, 'g', -1, 64)
+	}
+	return Unit{Name: name, Dim: dim, Scale: math.Pow(u.Scale, y)}, true
+}
+
+// parenthesize returns name of a compound unit in parentheses.
+func parenthesize(name string) string {
+	if strings.ContainsAny(name, "·/^") {
+		return "(" + name + ")"
+	}
+	return name
+}
+
+// DimensionError is returned by operations on quantities of incompatible dimensions.
+type DimensionError struct {
+	Op   string      // operation, e.g. "Add"
+	Dims []Dimension // dimensions of operands
+}
+
+func (e *DimensionError) Error() string {
+	dims := make([]string, len(e.Dims))
+	for i, d := range e.Dims {
+		dims[i] = d.String()
+	}
+	return fmt.Sprintf("uncertain: %s of incompatible dimensions %s", e.Op, strings.Join(dims, ", "))
+}
+
+// Quantity is an uncertain value with a unit of measurement.
+//
+// Add and Sub require equal dimensions of operands, Mul, Div, Sqrt and Pow combine dimensions,
+// trigonometric functions require dimensionless or angle arguments.
+type Quantity struct {
+	Uncertain Uncertain
+	Unit      Unit
+}
+
+// NewQuantity returns v measured in unit.
+func NewQuantity(v Uncertain, unit Unit) Quantity {
+	return Quantity{v, unit}
+}
+
+// String returns the value of q with the unit, e.g. "1.235 ± 0.012 mm".
+func (q Quantity) String() string {
+	if q.Unit.Name == "" {
+		return q.Uncertain.String()
+	}
+	return q.Uncertain.String() + " " + q.Unit.Name
+}
+
+// scaled returns v multiplied by an exact factor k.
+func scaled(v Uncertain, k float64) Uncertain {
+	return Uncertain{v.Value * k, v.Error * math.Abs(k)}
+}
+
+// Convert returns q in another unit of the same dimension, both value and error are scaled.
+// Offsets of units are taken into account, e.g. 20 °C is converted to 293.15 K.
+func (q Quantity) Convert(to Unit) (Quantity, error) {
+	res, ok := q.rescale(to)
+	if !ok {
+		return Quantity{}, &DimensionError{"Convert", []Dimension{q.Unit.Dim, to.Dim}}
+	}
+	res.Uncertain.Value += (q.Unit.Offset - to.Offset) / to.Scale
+	return res, nil
+}
+
+// rescale returns q in another unit of the same dimension ignoring offsets of units, ok is false if dimensions differ.
+func (q Quantity) rescale(to Unit) (res Quantity, ok bool) {
+	if q.Unit.Dim != to.Dim {
+		return Quantity{}, false
+	}
+	return Quantity{scaled(q.Uncertain, q.Unit.Scale/to.Scale), to}, true
+}
+
+// ToSI returns q in coherent SI units of its dimension, e.g. "m·kg·s^-2" for newtons.
+func (q Quantity) ToSI() Quantity {
+	name := q.Unit.Dim.String()
+	if q.Unit.Dim.IsDimensionless() {
+		name = ""
+	}
+	res, _ := q.Convert(Unit{Name: name, Dim: q.Unit.Dim, Scale: 1})
+	return res
+}
+
+// Add returns q1 + q2 in the unit of q1.
+func (q1 Quantity) Add(q2 Quantity) (Quantity, error) {
+	v2, ok := q2.rescale(q1.Unit)
+	if !ok {
+		return Quantity{}, &DimensionError{"Add", []Dimension{q1.Unit.Dim, q2.Unit.Dim}}
+	}
+	return Quantity{q1.Uncertain.Add(v2.Uncertain), q1.Unit}, nil
+}
+
+// Sub returns q1 - q2 in the unit of q1.
+func (q1 Quantity) Sub(q2 Quantity) (Quantity, error) {
+	v2, ok := q2.rescale(q1.Unit)
+	if !ok {
+		return Quantity{}, &DimensionError{"Sub", []Dimension{q1.Unit.Dim, q2.Unit.Dim}}
+	}
+	return Quantity{q1.Uncertain.Sub(v2.Uncertain), q1.Unit}, nil
+}
+
+// Mul returns q1 * q2 in the product of units.
+func (q1 Quantity) Mul(q2 Quantity) Quantity {
+	return Quantity{q1.Uncertain.Mul(q2.Uncertain), q1.Unit.Mul(q2.Unit)}
+}
+
+// Div returns q1 / q2 in the quotient of units.
+func (q1 Quantity) Div(q2 Quantity) Quantity {
+	return Quantity{q1.Uncertain.Div(q2.Uncertain), q1.Unit.Div(q2.Unit)}
+}
+
+// Sqrt returns the square root of q. All exponents of the dimension of q must be even.
+func (q Quantity) Sqrt() (Quantity, error) {
+	unit, ok := q.Unit.pow(0.5)
+	if !ok {
+		return Quantity{}, &DimensionError{"Sqrt", []Dimension{q.Unit.Dim}}
+	}
+	return Quantity{Sqrt(q.Uncertain), unit}, nil
+}
+
+// Pow returns q**y for an exact exponent y.
+// The exponents of the dimension of q multiplied by y must be integers.
+func (q Quantity) Pow(y float64) (Quantity, error) {
+	unit, ok := q.Unit.pow(y)
+	if !ok {
+		return Quantity{}, &DimensionError{"Pow", []Dimension{q.Unit.Dim}}
+	}
+	return Quantity{PowFloat(q.Uncertain, y), unit}, nil
+}
+
+// radians returns an angle or a dimensionless q as a number of radians.
+func (q Quantity) radians(op string) (Uncertain, error) {
+	if !q.Unit.Dim.IsDimensionless() && q.Unit.Dim != Radian.Dim {
+		return Uncertain{}, &DimensionError{op, []Dimension{q.Unit.Dim}}
+	}
+	return scaled(q.Uncertain, q.Unit.Scale), nil
+}
+
+// dimensionless returns a dimensionless q as a number.
+func (q Quantity) dimensionless(op string) (Uncertain, error) {
+	if !q.Unit.Dim.IsDimensionless() {
+		return Uncertain{}, &DimensionError{op, []Dimension{q.Unit.Dim}}
+	}
+	return scaled(q.Uncertain, q.Unit.Scale), nil
+}
+
+// Cos returns the cosine of an angle or a dimensionless q.
+func (q Quantity) Cos() (Uncertain, error) {
+	v, err := q.radians("Cos")
+	if err != nil {
+		return Uncertain{}, err
+	}
+	return Cos(v), nil
+}
+
+// Sin returns the sine of an angle or a dimensionless q.
+func (q Quantity) Sin() (Uncertain, error) {
+	v, err := q.radians("Sin")
+	if err != nil {
+		return Uncertain{}, err
+	}
+	return Sin(v), nil
+}
+
+// Tan returns the tangent of an angle or a dimensionless q.
+func (q Quantity) Tan() (Uncertain, error) {
+	v, err := q.radians("Tan")
+	if err != nil {
+		return Uncertain{}, err
+	}
+	return Tan(v), nil
+}
+
+// Acos returns the arccosine of a dimensionless q as an angle in radians.
+func (q Quantity) Acos() (Quantity, error) {
+	v, err := q.dimensionless("Acos")
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{Acos(v), Radian}, nil
+}
+
+// Asin returns the arcsine of a dimensionless q as an angle in radians.
+func (q Quantity) Asin() (Quantity, error) {
+	v, err := q.dimensionless("Asin")
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{Asin(v), Radian}, nil
+}
+
+// Atan returns the arctangent of a dimensionless q as an angle in radians.
+func (q Quantity) Atan() (Quantity, error) {
+	v, err := q.dimensionless("Atan")
+	if err != nil {
+		return Quantity{}, err
+	}
+	return Quantity{Atan(v), Radian}, nil
+}
+
+// Atan2 returns the arc tangent of y/x as an angle in radians. Quantities x and y must be of the same dimension.
+func (y Quantity) Atan2(x Quantity) (Quantity, error) {
+	xv, ok := x.rescale(y.Unit)
+	if !ok {
+		return Quantity{}, &DimensionError{"Atan2", []Dimension{y.Unit.Dim, x.Unit.Dim}}
+	}
+	return Quantity{Atan2(y.Uncertain, xv.Uncertain), Radian}, nil
+}
@@ -0,0 +1,141 @@
+package uncertain
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestDimension(t *testing.T) {
+	newton := Dimension{Length: 1, Mass: 1, Time: -2}
+
+	cases := []struct {
+		d   Dimension
+		res string
+	}{
+		{Dimension{}, "1"},
+		{Metre.Dim, "m"},
+		{newton, "m·kg·s^-2"},
+		{newton.Mul(Metre.Dim), "m^2·kg·s^-2"},
+		{newton.Div(newton), "1"},
+		{Degree.Dim, "rad"},
+	}
+
+	for i, the_case := range cases {
+		if s := the_case.d.String(); s != the_case.res {
+			t.Fatalf("Test case %d failed: %v is %q, got %q", i, [8]int8(the_case.d), the_case.res, s)
+		}
+	}
+}
+
+func TestQuantityConvert(t *testing.T) {
+	q := NewQuantity(Uncertain{25.4, 0.1}, Millimetre)
+
+	if s := q.String(); s != "25.40 ± 0.10 mm" {
+		t.Fatalf("25.4±0.1 mm is printed as %q", s)
+	}
+
+	res, err := q.Convert(Inch)
+	if err != nil || res.Unit != Inch || !almostEqual(res.Uncertain, Uncertain{1, 0.1 / 25.4}) {
+		t.Fatalf("25.4±0.1 mm is 1±0.0039 in, got %v (%v)", res, err)
+	}
+
+	si := q.ToSI()
+	if si.Unit.Name != "m" || si.Unit.Scale != 1 || !almostEqual(si.Uncertain, Uncertain{0.0254, 0.0001}) {
+		t.Fatalf("25.4±0.1 mm is 0.0254±0.0001 m, got %v", si)
+	}
+
+	var dimErr *DimensionError
+	if _, err := q.Convert(Second); !errors.As(err, &dimErr) || err.Error() != "uncertain: Convert of incompatible dimensions m, s" {
+		t.Fatalf("Conversion of mm to s must fail, got %v", err)
+	}
+}
+
+func TestQuantityArithmetics(t *testing.T) {
+	length := NewQuantity(Uncertain{2, 0.1}, Metre)
+	lengthMM := NewQuantity(Uncertain{500, 10}, Millimetre)
+	time := NewQuantity(Uncertain{4, 0.2}, Second)
+
+	sum, err := length.Add(lengthMM)
+	if err != nil || sum.Unit != Metre || !almostEqual(sum.Uncertain, Uncertain{2.5, 0.11}) {
+		t.Fatalf("2±0.1 m + 500±10 mm is 2.5±0.11 m, got %v (%v)", sum, err)
+	}
+
+	diff, err := lengthMM.Sub(length)
+	if err != nil || diff.Unit != Millimetre || !almostEqual(diff.Uncertain, Uncertain{-1500, 110}) {
+		t.Fatalf("500±10 mm - 2±0.1 m is -1500±110 mm, got %v (%v)", diff, err)
+	}
+
+	if _, err := length.Add(time); err == nil {
+		t.Fatalf("Metres are added to seconds without error")
+	}
+	if _, err := length.Sub(time); err == nil {
+		t.Fatalf("Seconds are subtracted from metres without error")
+	}
+
+	speed := length.Div(time)
+	if speed.Unit.Name != "m/s" || speed.Unit.Dim != (Dimension{Length: 1, Time: -1}) || !almostEqual(speed.Uncertain, Uncertain{0.5, 0.05}) {
+		t.Fatalf("2±0.1 m / 4±0.2 s is 0.5±0.05 m/s, got %v", speed)
+	}
+
+	acceleration := speed.Div(time)
+	if acceleration.Unit.Name != "m/s/s" || acceleration.Unit.Dim != (Dimension{Length: 1, Time: -2}) {
+		t.Fatalf("Unit of acceleration is m·s^-2, got %s (%v)", acceleration.Unit.Name, acceleration.Unit.Dim)
+	}
+
+	area := length.Mul(lengthMM)
+	if area.Unit.Name != "m·mm" || area.Unit.Dim != (Dimension{Length: 2}) || area.Unit.Scale != 1e-3 || !almostEqual(area.ToSI().Uncertain, Uncertain{1, 0.07}) {
+		t.Fatalf("2±0.1 m * 500±10 mm is 1±0.07 m^2, got %v", area.ToSI())
+	}
+
+	side, err := area.Sqrt()
+	if err != nil || side.Unit.Dim != Metre.Dim || !almostEqual(side.ToSI().Uncertain, Sqrt(Uncertain{1, 0.07})) {
+		t.Fatalf("Square root of 1±0.07 m^2 is 1±0.035 m, got %v (%v)", side.ToSI(), err)
+	}
+	if _, err := length.Sqrt(); err == nil {
+		t.Fatalf("Square root of metres is taken without error")
+	}
+
+	volume, err := length.Pow(3)
+	if err != nil || volume.Unit.Name != "m^3" || volume.Unit.Dim != (Dimension{Length: 3}) || !almostEqual(volume.Uncertain, PowFloat(length.Uncertain, 3)) {
+		t.Fatalf("(2±0.1 m)**3 is 8±1.2 m^3, got %v (%v)", volume, err)
+	}
+	if _, err := volume.Pow(0.5); err == nil {
+		t.Fatalf("Cubic metres are raised to 0.5 without error")
+	}
+}
+
+func TestQuantityTrigonometry(t *testing.T) {
+	angle := NewQuantity(Uncertain{30, 1}, Degree)
+
+	sin, err := angle.Sin()
+	if err != nil || !almostEqual(sin, Sin(Uncertain{math.Pi / 6, math.Pi / 180})) {
+		t.Fatalf("Sine of 30±1° is 0.5±0.015, got %v (%v)", sin, err)
+	}
+
+	cos, err := NewQuantity(Uncertain{0.5, 0.01}, One).Cos()
+	if err != nil || !almostEqual(cos, Cos(Uncertain{0.5, 0.01})) {
+		t.Fatalf("Cosine of dimensionless 0.5±0.01 is wrong: %v (%v)", cos, err)
+	}
+
+	if _, err := NewQuantity(Uncertain{1, 0.1}, Metre).Tan(); err == nil {
+		t.Fatalf("Tangent of metres is taken without error")
+	}
+
+	ratio := NewQuantity(Uncertain{2, 0.1}, Millimetre).Div(NewQuantity(Uncertain{4, 0.1}, Metre))
+	asin, err := ratio.Asin()
+	if err != nil || asin.Unit != Radian || !almostEqual(asin.Uncertain, Asin(scaled(Uncertain{2, 0.1}.Div(Uncertain{4, 0.1}), 1e-3))) {
+		t.Fatalf("Arcsine of 2 mm / 4 m is wrong: %v (%v)", asin, err)
+	}
+	if _, err := angle.Acos(); err == nil {
+		t.Fatalf("Arccosine of an angle is taken without error")
+	}
+
+	direction, err := NewQuantity(Uncertain{1, 0.01}, Metre).Atan2(NewQuantity(Uncertain{1000, 10}, Millimetre))
+	if err != nil || direction.Unit != Radian || !almostEqual(direction.Uncertain, Atan2(Uncertain{1, 0.01}, Uncertain{1, 0.01})) {
+		t.Fatalf("Direction of (1000±10 mm, 1±0.01 m) is π/4±0.01, got %v (%v)", direction, err)
+	}
+	if _, err := NewQuantity(Uncertain{1, 0.01}, Metre).Atan2(NewQuantity(Uncertain{1, 0.01}, Second)); err == nil {
+		t.Fatalf("Atan2 of metres and seconds is taken without error")
+	}
+}
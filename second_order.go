@@ -0,0 +1,171 @@
+package uncertain
+
+import "math"
+
+// SecondOrder propagates error through functions of a single argument using second-order Taylor expansion.
+//
+// First-order propagation |f'(x)|·Δx loses the error where the derivative is zero, e.g. Cos at 0.
+// SecondOrder treats the error of an argument as a standard deviation of a normal distribution
+// and includes the curvature of the function:
+//
+//	value = f(x) + f''(x)·Δx²/2
+//	error = sqrt(f'(x)²·Δx² + f''(x)²·Δx⁴/2)
+//
+// The value of the result is the expected value of f, i.e. it is shifted by the bias caused by the curvature.
+// Special cases of values are the same as for the first-order functions.
+type SecondOrder struct{}
+
+// propagate returns the result of function with value f, first derivative d1 and second derivative d2 at v.Value.
+// If a derivative is not finite, e.g. at an edge of the domain, the result is that of the first-order function.
+func (SecondOrder) propagate(v Uncertain, f, d1, d2 float64, first func(Uncertain) Uncertain) (result Uncertain) {
+	if v.Error == 0 {
+		result.Value = f
+		result.Error = 0
+		return
+	}
+	if !finite(d1) || !finite(d2) {
+		return first(v)
+	}
+
+	s2 := v.Error * v.Error
+
+	result.Value = f + d2*s2/2
+	result.Error = math.Sqrt(d1*d1*s2 + d2*d2*s2*s2/2)
+	return
+}
+
+// Sqrt returns the square root of v.Value and propagates error.
+func (so SecondOrder) Sqrt(v Uncertain) Uncertain {
+	s := math.Sqrt(v.Value)
+	return so.propagate(v, s, 1/(2*s), -1/(4*s*v.Value), Sqrt)
+}
+
+// Cbrt returns the cube root of v.Value and propagates error.
+func (so SecondOrder) Cbrt(v Uncertain) Uncertain {
+	c := math.Cbrt(v.Value)
+	return so.propagate(v, c, 1/(3*c*c), -2/(9*c*c*v.Value), Cbrt)
+}
+
+// PowFloat returns v.Value**y for an exact exponent y and propagates error.
+func (so SecondOrder) PowFloat(v Uncertain, y float64) Uncertain {
+	return so.propagate(v, math.Pow(v.Value, y), y*math.Pow(v.Value, y-1), y*(y-1)*math.Pow(v.Value, y-2), func(v Uncertain) Uncertain { return PowFloat(v, y) })
+}
+
+// Exp returns e**v.Value and propagates error.
+func (so SecondOrder) Exp(v Uncertain) Uncertain {
+	e := math.Exp(v.Value)
+	return so.propagate(v, e, e, e, Exp)
+}
+
+// Exp2 returns 2**v.Value and propagates error.
+func (so SecondOrder) Exp2(v Uncertain) Uncertain {
+	e := math.Exp2(v.Value)
+	return so.propagate(v, e, e*math.Ln2, e*math.Ln2*math.Ln2, Exp2)
+}
+
+// Exp10 returns 10**v.Value and propagates error.
+func (so SecondOrder) Exp10(v Uncertain) Uncertain {
+	e := math.Pow(10, v.Value)
+	return so.propagate(v, e, e*math.Ln10, e*math.Ln10*math.Ln10, Exp10)
+}
+
+// Expm1 returns e**v.Value - 1 and propagates error.
+func (so SecondOrder) Expm1(v Uncertain) Uncertain {
+	e := math.Exp(v.Value)
+	return so.propagate(v, math.Expm1(v.Value), e, e, Expm1)
+}
+
+// Log returns the natural logarithm of v.Value and propagates error.
+func (so SecondOrder) Log(v Uncertain) Uncertain {
+	return so.propagate(v, math.Log(v.Value), 1/v.Value, -1/(v.Value*v.Value), Log)
+}
+
+// Log10 returns the decimal logarithm of v.Value and propagates error.
+func (so SecondOrder) Log10(v Uncertain) Uncertain {
+	return so.propagate(v, math.Log10(v.Value), 1/(v.Value*math.Ln10), -1/(v.Value*v.Value*math.Ln10), Log10)
+}
+
+// Log2 returns the binary logarithm of v.Value and propagates error.
+func (so SecondOrder) Log2(v Uncertain) Uncertain {
+	return so.propagate(v, math.Log2(v.Value), 1/(v.Value*math.Ln2), -1/(v.Value*v.Value*math.Ln2), Log2)
+}
+
+// Log1p returns the natural logarithm of 1 plus v.Value and propagates error.
+func (so SecondOrder) Log1p(v Uncertain) Uncertain {
+	x := 1 + v.Value
+	return so.propagate(v, math.Log1p(v.Value), 1/x, -1/(x*x), Log1p)
+}
+
+// Cos returns the cosine of the radian argument v.Value and propagates error.
+func (so SecondOrder) Cos(v Uncertain) Uncertain {
+	s, c := math.Sincos(v.Value)
+	return so.propagate(v, c, -s, -c, Cos)
+}
+
+// Sin returns the sine of the radian argument v.Value and propagates error.
+func (so SecondOrder) Sin(v Uncertain) Uncertain {
+	s, c := math.Sincos(v.Value)
+	return so.propagate(v, s, c, -s, Sin)
+}
+
+// Tan returns the tangent of the radian argument v.Value and propagates error.
+func (so SecondOrder) Tan(v Uncertain) Uncertain {
+	t := math.Tan(v.Value)
+	d1 := 1 + t*t
+	return so.propagate(v, t, d1, 2*t*d1, Tan)
+}
+
+// Acos returns the arccosine, in radians, of v.Value and propagates error.
+func (so SecondOrder) Acos(v Uncertain) Uncertain {
+	r := 1 - v.Value*v.Value
+	return so.propagate(v, math.Acos(v.Value), -1/math.Sqrt(r), -v.Value/(r*math.Sqrt(r)), Acos)
+}
+
+// Asin returns the arcsine, in radians, of v.Value and propagates error.
+func (so SecondOrder) Asin(v Uncertain) Uncertain {
+	r := 1 - v.Value*v.Value
+	return so.propagate(v, math.Asin(v.Value), 1/math.Sqrt(r), v.Value/(r*math.Sqrt(r)), Asin)
+}
+
+// Atan returns the arctangent, in radians, of v.Value and propagates error.
+func (so SecondOrder) Atan(v Uncertain) Uncertain {
+	r := 1 + v.Value*v.Value
+	return so.propagate(v, math.Atan(v.Value), 1/r, -2*v.Value/(r*r), Atan)
+}
+
+// Cosh returns the hyperbolic cosine of v.Value and propagates error.
+func (so SecondOrder) Cosh(v Uncertain) Uncertain {
+	s, c := math.Sinh(v.Value), math.Cosh(v.Value)
+	return so.propagate(v, c, s, c, Cosh)
+}
+
+// Sinh returns the hyperbolic sine of v.Value and propagates error.
+func (so SecondOrder) Sinh(v Uncertain) Uncertain {
+	s, c := math.Sinh(v.Value), math.Cosh(v.Value)
+	return so.propagate(v, s, c, s, Sinh)
+}
+
+// Tanh returns the hyperbolic tangent of v.Value and propagates error.
+func (so SecondOrder) Tanh(v Uncertain) Uncertain {
+	t := math.Tanh(v.Value)
+	d1 := 1 - t*t
+	return so.propagate(v, t, d1, -2*t*d1, Tanh)
+}
+
+// Acosh returns the inverse hyperbolic cosine of v.Value and propagates error.
+func (so SecondOrder) Acosh(v Uncertain) Uncertain {
+	r := v.Value*v.Value - 1
+	return so.propagate(v, math.Acosh(v.Value), 1/math.Sqrt(r), -v.Value/(r*math.Sqrt(r)), Acosh)
+}
+
+// Asinh returns the inverse hyperbolic sine of v.Value and propagates error.
+func (so SecondOrder) Asinh(v Uncertain) Uncertain {
+	r := 1 + v.Value*v.Value
+	return so.propagate(v, math.Asinh(v.Value), 1/math.Sqrt(r), -v.Value/(r*math.Sqrt(r)), Asinh)
+}
+
+// Atanh returns the inverse hyperbolic tangent of v.Value and propagates error.
+func (so SecondOrder) Atanh(v Uncertain) Uncertain {
+	r := 1 - v.Value*v.Value
+	return so.propagate(v, math.Atanh(v.Value), 1/r, 2*v.Value/(r*r), Atanh)
+}
@@ -0,0 +1,92 @@
+package uncertain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSecondOrder(t *testing.T) {
+	var so SecondOrder
+
+	cases := []struct {
+		name string
+		f    func(Uncertain) Uncertain
+		v    Uncertain
+		res  Uncertain
+	}{
+		{"Cos", so.Cos, Uncertain{0, 0}, Uncertain{1, 0}},
+		{"Cos", so.Cos, Uncertain{0, 0.1}, Uncertain{0.995, 0.00707106781186548}},
+		{"Sin", so.Sin, Uncertain{math.Pi / 2, 0.1}, Uncertain{0.995, 0.00707106781186548}},
+		{"Sqrt", so.Sqrt, Uncertain{4, 0.4}, Uncertain{1.9975, 0.100062480480948}},
+		{"Exp", so.Exp, Uncertain{1, 0.1}, Uncertain{2.73187323760134, 0.272506905956992}},
+		{"Log", so.Log, Uncertain{10, 1}, Uncertain{2.29758509299405, 0.100249688278817}},
+		{"Cosh", so.Cosh, Uncertain{0, 0.2}, Uncertain{1.02, 0.0282842712474619}},
+		{"Asin", so.Asin, Uncertain{0.5, 0.1}, Uncertain{0.527447777392896, 0.115598282699022}},
+		{"Tan", so.Tan, Uncertain{1, 0.05}, Uncertain{1.57074504833612, 0.172311393660853}},
+		{"Pow3", func(v Uncertain) Uncertain { return so.PowFloat(v, 3) }, Uncertain{2, 0.1}, Uncertain{8.06, 1.20299625934581}},
+		// Edges of domains, where derivatives are infinite, are the same as for first-order functions
+		{"Acos", so.Acos, Uncertain{1, 0.1}, Uncertain{0, 0.451026811796262}},
+		{"Acos", so.Acos, Uncertain{-1, 0.1}, Uncertain{math.Pi, 0.451026811796262}},
+		{"Asin", so.Asin, Uncertain{1, 0.1}, Uncertain{math.Pi / 2, 0.451026811796262}},
+		{"Sqrt", so.Sqrt, Uncertain{0, 0.1}, Uncertain{0, 0.316227766016838}},
+		{"Cbrt", so.Cbrt, Uncertain{0, 0.1}, Uncertain{0, 0.464158883361278}},
+		{"Acosh", so.Acosh, Uncertain{1, 0.1}, Uncertain{0, 0.443568254385115}},
+		{"Sqrt", func(v Uncertain) Uncertain { return so.PowFloat(v, 0.5) }, Uncertain{0, 0.1}, PowFloat(Uncertain{0, 0.1}, 0.5)},
+	}
+
+	for i, the_case := range cases {
+		res := the_case.f(the_case.v)
+
+		if !almostEqual(res, the_case.res) {
+			t.Fatalf("Test case %d failed: %s(%f±%f) is %f±%f, got %f±%f",
+				i, the_case.name, the_case.v.Value, the_case.v.Error, the_case.res.Value, the_case.res.Error, res.Value, res.Error)
+		}
+	}
+}
+
+func TestSecondOrderMatchesMonteCarlo(t *testing.T) {
+	var so SecondOrder
+
+	cases := []struct {
+		name string
+		f    func(Uncertain) Uncertain
+		g    func(float64) float64
+		v    Uncertain
+	}{
+		{"Sqrt", so.Sqrt, math.Sqrt, Uncertain{4, 0.2}},
+		{"Cbrt", so.Cbrt, math.Cbrt, Uncertain{8, 0.3}},
+		{"Exp", so.Exp, math.Exp, Uncertain{1, 0.1}},
+		{"Exp2", so.Exp2, math.Exp2, Uncertain{1, 0.1}},
+		{"Exp10", so.Exp10, func(x float64) float64 { return math.Pow(10, x) }, Uncertain{1, 0.05}},
+		{"Expm1", so.Expm1, math.Expm1, Uncertain{0, 0.1}},
+		{"Log", so.Log, math.Log, Uncertain{2, 0.1}},
+		{"Log10", so.Log10, math.Log10, Uncertain{2, 0.1}},
+		{"Log2", so.Log2, math.Log2, Uncertain{2, 0.1}},
+		{"Log1p", so.Log1p, math.Log1p, Uncertain{1, 0.1}},
+		{"Cos", so.Cos, math.Cos, Uncertain{0, 0.1}},
+		{"Sin", so.Sin, math.Sin, Uncertain{1, 0.1}},
+		{"Tan", so.Tan, math.Tan, Uncertain{0.5, 0.05}},
+		{"Acos", so.Acos, math.Acos, Uncertain{0.3, 0.05}},
+		{"Asin", so.Asin, math.Asin, Uncertain{0.3, 0.05}},
+		{"Atan", so.Atan, math.Atan, Uncertain{1, 0.1}},
+		{"Cosh", so.Cosh, math.Cosh, Uncertain{0, 0.1}},
+		{"Sinh", so.Sinh, math.Sinh, Uncertain{1, 0.1}},
+		{"Tanh", so.Tanh, math.Tanh, Uncertain{0.5, 0.1}},
+		{"Acosh", so.Acosh, math.Acosh, Uncertain{2, 0.1}},
+		{"Asinh", so.Asinh, math.Asinh, Uncertain{1, 0.1}},
+		{"Atanh", so.Atanh, math.Atanh, Uncertain{0.3, 0.05}},
+	}
+
+	mc := MonteCarlo{Seed: 1}
+
+	for i, the_case := range cases {
+		res := the_case.f(the_case.v)
+		g := the_case.g
+		ref := mc.Run(func(x []float64) float64 { return g(x[0]) }, []Uncertain{the_case.v}).Uncertain()
+
+		if math.Abs(res.Value-ref.Value) > 0.01*ref.Error || math.Abs(res.Error/ref.Error-1) > 0.02 {
+			t.Fatalf("Test case %d failed: %s(%f±%f) is %f±%f by Monte Carlo, got %f±%f",
+				i, the_case.name, the_case.v.Value, the_case.v.Error, ref.Value, ref.Error, res.Value, res.Error)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package uncertain
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+// Valuer returns a driver.Valuer of v for query arguments.
+// An Uncertain can't implement driver.Valuer itself, since its field Value takes the name of the method:
+//
+//	db.Exec("INSERT INTO measurements (name, length) VALUES (?, ?)", name, v.Valuer())
+//
+// An Uncertain is stored as a string, see MarshalText.
+func (v Uncertain) Valuer() driver.Valuer {
+	return NullUncertain{v, true}
+}
+
+// Scan implements sql.Scanner. A string is parsed by Parse, a number is an exact value.
+// NULL can't be scanned into an Uncertain, use NullUncertain for nullable columns.
+func (v *Uncertain) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return v.UnmarshalText([]byte(src))
+	case []byte:
+		return v.UnmarshalText(src)
+	case float64:
+		*v = Uncertain{src, 0}
+	case int64:
+		*v = Uncertain{float64(src), 0}
+	case nil:
+		return errors.New("uncertain: can't scan NULL into Uncertain, use NullUncertain")
+	default:
+		return fmt.Errorf("uncertain: can't scan %T into Uncertain", src)
+	}
+	return nil
+}
+
+// ValueColumn returns a sql.Scanner for a column with the value of v,
+// so that a value and an error stored in two columns are scanned together:
+//
+//	rows.Scan(&name, v.ValueColumn(), v.ErrorColumn())
+//
+// The value column can't be NULL.
+func (v *Uncertain) ValueColumn() sql.Scanner {
+	return column{&v.Value, "value", false}
+}
+
+// ErrorColumn returns a sql.Scanner for a column with the error of v, see ValueColumn.
+// NULL in the error column is scanned as zero error.
+func (v *Uncertain) ErrorColumn() sql.Scanner {
+	return column{&v.Error, "error", true}
+}
+
+// column is a sql.Scanner of a single float64 field of an Uncertain.
+type column struct {
+	dst      *float64
+	name     string
+	nullable bool
+}
+
+func (c column) Scan(src any) error {
+	var f sql.NullFloat64
+	if err := f.Scan(src); err != nil {
+		return fmt.Errorf("uncertain: scanning %s column: %w", c.name, err)
+	}
+	if !f.Valid && !c.nullable {
+		return fmt.Errorf("uncertain: %s column is NULL", c.name)
+	}
+	*c.dst = f.Float64
+	return nil
+}
+
+// NullUncertain represents an Uncertain that may be null.
+// NullUncertain implements the sql.Scanner interface so it can be used as a scan destination,
+// similar to sql.NullFloat64.
+type NullUncertain struct {
+	Uncertain Uncertain
+	Valid     bool // Valid is true if Uncertain is not NULL
+}
+
+// Scan implements sql.Scanner.
+func (n *NullUncertain) Scan(value any) error {
+	if value == nil {
+		n.Uncertain, n.Valid = Uncertain{}, false
+		return nil
+	}
+	n.Valid = true
+	return n.Uncertain.Scan(value)
+}
+
+// Value implements driver.Valuer. A valid Uncertain is stored as a string, see MarshalText.
+func (n NullUncertain) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	text, err := n.Uncertain.MarshalText()
+	return string(text), err
+}
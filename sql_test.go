@@ -0,0 +1,92 @@
+package uncertain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSQL(t *testing.T) {
+	for i, v := range marshalValues {
+		value, err := v.Valuer().Value()
+		var res Uncertain
+		if err == nil {
+			err = res.Scan(value)
+		}
+		if err != nil || !sameUncertain(res, v) {
+			t.Fatalf("Test case %d failed: %#v is scanned from %#v as %#v (%v)", i, v, value, res, err)
+		}
+	}
+
+	cases := []struct {
+		src any
+		res Uncertain
+	}{
+		{"1.23 ± 0.05", Uncertain{1.23, 0.05}},
+		{[]byte("1.23(5)"), Uncertain{1.23, 0.05}},
+		{1.5, Uncertain{1.5, 0}},
+		{int64(42), Uncertain{42, 0}},
+	}
+
+	for i, the_case := range cases {
+		var res Uncertain
+		if err := res.Scan(the_case.src); err != nil || res != the_case.res {
+			t.Fatalf("Test case %d failed: %#v is scanned as %#v, got %#v (%v)", i, the_case.src, the_case.res, res, err)
+		}
+	}
+
+	var res Uncertain
+	for _, src := range []any{nil, true, "x ± 1"} {
+		if err := res.Scan(src); err == nil {
+			t.Fatalf("%#v is scanned without error", src)
+		}
+	}
+}
+
+func TestSQLColumns(t *testing.T) {
+	var v Uncertain
+
+	if err := v.ValueColumn().Scan([]byte("1.25")); err != nil {
+		t.Fatalf("Can't scan value column: %v", err)
+	}
+	if err := v.ErrorColumn().Scan(0.5); err != nil {
+		t.Fatalf("Can't scan error column: %v", err)
+	}
+	if v != (Uncertain{1.25, 0.5}) {
+		t.Fatalf("Scanned columns are 1.25±0.5, got %#v", v)
+	}
+
+	if err := v.ErrorColumn().Scan(nil); err != nil || v != (Uncertain{1.25, 0}) {
+		t.Fatalf("NULL error column is zero error, got %#v (%v)", v, err)
+	}
+	if err := v.ValueColumn().Scan(nil); err == nil {
+		t.Fatalf("NULL value column is scanned without error")
+	}
+	if err := v.ValueColumn().Scan("abc"); err == nil {
+		t.Fatalf("Malformed value column is scanned without error")
+	}
+}
+
+func TestNullUncertain(t *testing.T) {
+	var n NullUncertain
+
+	if err := n.Scan("1.23 ± 0.05"); err != nil || !n.Valid || n.Uncertain != (Uncertain{1.23, 0.05}) {
+		t.Fatalf("1.23 ± 0.05 is scanned as %#v (%v)", n, err)
+	}
+	if value, err := n.Value(); err != nil || value != "1.23 ± 0.05" {
+		t.Fatalf("Value of %#v is %q, got %#v (%v)", n, "1.23 ± 0.05", value, err)
+	}
+
+	if err := n.Scan(nil); err != nil || n.Valid || n.Uncertain != (Uncertain{}) {
+		t.Fatalf("NULL is scanned as %#v (%v)", n, err)
+	}
+	if value, err := n.Value(); err != nil || value != nil {
+		t.Fatalf("Value of NULL is nil, got %#v (%v)", value, err)
+	}
+
+	n = NullUncertain{Uncertain{math.NaN(), 1}, true}
+	value, _ := n.Value()
+	var res NullUncertain
+	if err := res.Scan(value); err != nil || !res.Valid || !sameUncertain(res.Uncertain, n.Uncertain) {
+		t.Fatalf("%#v is scanned from %#v as %#v (%v)", n, value, res, err)
+	}
+}
@@ -0,0 +1,158 @@
+package uncertain
+
+import (
+	"math"
+	"slices"
+)
+
+// madScale makes the median absolute deviation a consistent estimator of the standard deviation of the normal distribution.
+const madScale = 1.482602218505602
+
+// SampleOptions defines how FromSamples estimates a value and its error from repeated measurements.
+type SampleOptions struct {
+	// StandardError makes the error the standard error of the value, i.e. the uncertainty of the mean or the median,
+	// instead of the standard deviation of a single sample.
+	StandardError bool
+	// Biased disables the Bessel correction: the variance is divided by n instead of n - 1.
+	Biased bool
+	// Robust estimates the value by the median and the standard deviation by the median absolute deviation,
+	// scaled to be consistent with the standard deviation of the normal distribution. Biased has no effect then.
+	Robust bool
+}
+
+// FromSamples returns a value and an error estimated from samples, and the degrees of freedom of the error, n - 1,
+// for coverage intervals by the Student's t-distribution.
+//
+// By default the value is the mean and the error is the standard deviation with the Bessel correction.
+// The standard error of the median is approximated for large samples of the normal distribution as √(π/2)·σ/√n.
+//
+// Special cases are:
+//
+//	FromSamples(nil, opts) = NaN ± NaN, 0
+//	FromSamples([]float64{x}, opts) = x ± NaN, 0 unless opts.Biased and not opts.Robust
+//	FromSamples(samples, opts) = NaN ± NaN if any sample is NaN
+func FromSamples(samples []float64, opts SampleOptions) (v Uncertain, dof int) {
+	n := len(samples)
+	if n == 0 {
+		return Uncertain{math.NaN(), math.NaN()}, 0
+	}
+	dof = n - 1
+	if slices.ContainsFunc(samples, math.IsNaN) {
+		return Uncertain{math.NaN(), math.NaN()}, dof
+	}
+
+	if opts.Robust {
+		v.Value = median(slices.Clone(samples))
+		if n == 1 {
+			v.Error = math.NaN()
+			return
+		}
+
+		deviations := make([]float64, n)
+		for i, s := range samples {
+			deviations[i] = math.Abs(s - v.Value)
+		}
+		v.Error = madScale * median(deviations)
+		if opts.StandardError {
+			v.Error *= math.Sqrt(math.Pi / 2 / float64(n))
+		}
+		return
+	}
+
+	sum := 0.0
+	for _, s := range samples {
+		sum += s
+	}
+	v.Value = sum / float64(n)
+
+	sum = 0
+	for _, s := range samples {
+		d := s - v.Value
+		sum += d * d
+	}
+	if opts.Biased {
+		v.Error = math.Sqrt(sum / float64(n))
+	} else {
+		v.Error = math.Sqrt(sum / float64(n-1))
+	}
+	if opts.StandardError {
+		v.Error /= math.Sqrt(float64(n))
+	}
+	return
+}
+
+// median returns the median of non-empty x, which is sorted in place.
+func median(x []float64) float64 {
+	slices.Sort(x)
+	n := len(x)
+	if n%2 == 1 {
+		return x[n/2]
+	}
+	return (x[n/2-1] + x[n/2]) / 2
+}
+
+// WeightedMeanResult is a combination of independent measurements of the same quantity by WeightedMean.
+type WeightedMeanResult struct {
+	Mean              Uncertain
+	ChiSquared        float64 // Sum of squared deviations of measurements from the mean in units of their errors.
+	ReducedChiSquared float64 // ChiSquared per degree of freedom, n - 1.
+	BirgeRatio        float64 // Square root of ReducedChiSquared, about 1 for mutually consistent measurements.
+}
+
+// WeightedMean returns the mean of independent measurements weighted by inverse variances 1/σ²,
+// with the error 1/√(Σ1/σ²), and statistics of consistency of the measurements.
+// Errors are considered standard deviations.
+//
+// Exact measurements have infinite weights, so if there are any, the mean is their mean and is exact,
+// and ChiSquared is infinite unless they are all equal.
+//
+// Special cases are:
+//
+//	WeightedMean(nil) has the NaN ± NaN mean and NaN statistics
+//	WeightedMean of a single measurement has zero ChiSquared, NaN ReducedChiSquared and BirgeRatio
+//	WeightedMean has the NaN ± NaN mean and NaN statistics if any value or error is NaN
+func WeightedMean(values []Uncertain) (res WeightedMeanResult) {
+	if len(values) == 0 || slices.ContainsFunc(values, func(v Uncertain) bool { return math.IsNaN(v.Value) || math.IsNaN(v.Error) }) {
+		return WeightedMeanResult{Uncertain{math.NaN(), math.NaN()}, math.NaN(), math.NaN(), math.NaN()}
+	}
+
+	exact, sum, weights := 0, 0.0, 0.0
+	for _, v := range values {
+		if v.Error == 0 {
+			exact++
+			sum += v.Value
+		}
+	}
+	if exact > 0 {
+		res.Mean.Value = sum / float64(exact)
+	} else {
+		for _, v := range values {
+			w := 1 / (v.Error * v.Error)
+			sum += w * v.Value
+			weights += w
+		}
+		res.Mean = Uncertain{sum / weights, 1 / math.Sqrt(weights)}
+	}
+
+	for _, v := range values {
+		d := v.Value - res.Mean.Value
+		switch {
+		case v.Error != 0:
+			res.ChiSquared += d * d / (v.Error * v.Error)
+		case d != 0:
+			res.ChiSquared = math.Inf(1)
+		}
+	}
+	res.ReducedChiSquared = res.ChiSquared / float64(len(values)-1)
+	res.BirgeRatio = math.Sqrt(res.ReducedChiSquared)
+	return
+}
+
+// Inflated returns the mean with the error multiplied by the Birge ratio if it is greater than 1,
+// i.e. the scale factor of the Particle Data Group for mutually inconsistent measurements.
+func (r WeightedMeanResult) Inflated() Uncertain {
+	if r.BirgeRatio > 1 {
+		return Uncertain{r.Mean.Value, r.Mean.Error * r.BirgeRatio}
+	}
+	return r.Mean
+}
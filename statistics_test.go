@@ -0,0 +1,121 @@
+package uncertain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFromSamples(t *testing.T) {
+	samples := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+	options := []SampleOptions{
+		{},
+		{Biased: true},
+		{StandardError: true},
+		{StandardError: true, Biased: true},
+		{Robust: true},
+		{Robust: true, StandardError: true},
+	}
+	res := []Uncertain{
+		{5, math.Sqrt(32.0 / 7)},
+		{5, 2},
+		{5, math.Sqrt(32.0/7) / math.Sqrt(8)},
+		{5, 2 / math.Sqrt(8)},
+		{4.5, 0.5 * madScale},
+		{4.5, 0.5 * madScale * math.Sqrt(math.Pi/16)},
+	}
+
+	for the_case := range options {
+		v, dof := FromSamples(samples, options[the_case])
+		if math.Abs(v.Value-res[the_case].Value) > 1e-15 || math.Abs(v.Error-res[the_case].Error) > 1e-15 || dof != 7 {
+			t.Fatalf("Test case %d failed: expected %v with 7 degrees of freedom, got %v with %d", the_case, res[the_case], v, dof)
+		}
+	}
+}
+
+func TestFromSamplesRobust(t *testing.T) {
+	// An outlier shifts the mean and inflates the standard deviation, but barely affects the median and MAD
+	samples := []float64{9.8, 10.1, 10.0, 9.9, 10.2, 1000}
+
+	v, _ := FromSamples(samples, SampleOptions{})
+	if v.Value < 100 || v.Error < 100 {
+		t.Fatalf("Mean and standard deviation must be affected by the outlier, got %v", v)
+	}
+
+	v, _ = FromSamples(samples, SampleOptions{Robust: true})
+	if math.Abs(v.Value-10.05) > 1e-12 || math.Abs(v.Error-0.15*madScale) > 1e-12 {
+		t.Fatalf("Median and MAD are 10.05 and %f, got %v", 0.15*madScale, v)
+	}
+}
+
+func TestFromSamplesSpecialCases(t *testing.T) {
+	samples := [][]float64{nil, {3}, {3}, {3}, {1, math.NaN(), 2}}
+	options := []SampleOptions{{}, {}, {Biased: true}, {Robust: true}, {Robust: true}}
+	res := []Uncertain{{math.NaN(), math.NaN()}, {3, math.NaN()}, {3, 0}, {3, math.NaN()}, {math.NaN(), math.NaN()}}
+	dofs := []int{0, 0, 0, 0, 2}
+
+	for the_case := range samples {
+		v, dof := FromSamples(samples[the_case], options[the_case])
+		if !sameUncertain(v, res[the_case]) || dof != dofs[the_case] {
+			t.Fatalf("Test case %d failed: expected %v with %d degrees of freedom, got %v with %d", the_case, res[the_case], dofs[the_case], v, dof)
+		}
+	}
+}
+
+func TestWeightedMean(t *testing.T) {
+	values := [][]Uncertain{
+		{{10, 1}, {12, 1}},
+		{{10, 1}, {13, 2}},
+		{{10, 1}, {20, 1}},
+		{{10, 1}, {11, 1}, {10.5, 0.5}},
+	}
+	res := []WeightedMeanResult{
+		{Uncertain{11, 1 / math.Sqrt(2)}, 2, 2, math.Sqrt(2)},
+		{Uncertain{10.6, 2 / math.Sqrt(5)}, 1.8, 1.8, math.Sqrt(1.8)},
+		{Uncertain{15, 1 / math.Sqrt(2)}, 50, 50, math.Sqrt(50)},
+		{Uncertain{10.5, 1 / math.Sqrt(6)}, 0.5, 0.25, 0.5},
+	}
+
+	for the_case := range values {
+		r := WeightedMean(values[the_case])
+		e := res[the_case]
+		if !almostEqual(r.Mean, e.Mean) || math.Abs(r.ChiSquared-e.ChiSquared) > 1e-12 ||
+			math.Abs(r.ReducedChiSquared-e.ReducedChiSquared) > 1e-12 || math.Abs(r.BirgeRatio-e.BirgeRatio) > 1e-12 {
+			t.Fatalf("Test case %d failed: expected %+v, got %+v", the_case, e, r)
+		}
+
+		inflated := e.Mean
+		inflated.Error *= math.Max(e.BirgeRatio, 1)
+		if !almostEqual(r.Inflated(), inflated) {
+			t.Fatalf("Test case %d failed: inflated mean is %v, got %v", the_case, inflated, r.Inflated())
+		}
+	}
+}
+
+func TestWeightedMeanSpecialCases(t *testing.T) {
+	values := [][]Uncertain{
+		nil,
+		{{3, 0.1}},
+		{{3, 0}, {4, 1}},
+		{{3, 0}, {4, 0}},
+		{{3, 0.1}, {math.NaN(), 0.1}},
+	}
+	nan := math.NaN()
+	res := []WeightedMeanResult{
+		{Uncertain{nan, nan}, nan, nan, nan},
+		{Uncertain{3, 0.1}, 0, nan, nan},
+		{Uncertain{3, 0}, 1, 1, 1},
+		{Uncertain{3.5, 0}, math.Inf(1), math.Inf(1), math.Inf(1)},
+		{Uncertain{nan, nan}, nan, nan, nan},
+	}
+
+	same := func(a, b float64) bool { return a == b || math.IsNaN(a) && math.IsNaN(b) }
+	for the_case := range values {
+		r := WeightedMean(values[the_case])
+		e := res[the_case]
+		if !sameUncertain(r.Mean, e.Mean) || !same(r.ChiSquared, e.ChiSquared) ||
+			!same(r.ReducedChiSquared, e.ReducedChiSquared) || !same(r.BirgeRatio, e.BirgeRatio) {
+			t.Fatalf("Test case %d failed: expected %+v, got %+v", the_case, e, r)
+		}
+	}
+}
@@ -6,6 +6,13 @@
 // For * and / operations relative error is a sum of relative errors of operands.
 //
 // For function f(x) abolute error of a result is an abolute error of an argument multiplied by a function's derivative.
+//
+// The rules above are the worst-case (Linear) propagation. For independent random errors
+// the Quadrature propagator combines errors as a root sum of squares instead, see Propagator.
+// For strongly non-linear functions SecondOrder also takes the curvature of a function into account.
+//
+// Functions without a hand-written rule can be written with Dual numbers and evaluated by Eval,
+// which calculates the derivatives automatically.
 package uncertain
 
 // Uncetrain type represents an uncertain value, i.e., value with error
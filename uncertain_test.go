@@ -8,6 +8,12 @@ func almostEqual(a, b Uncertain) bool {
 	if a.Value == math.Inf(1) || b.Value == math.Inf(1) || a.Value == math.Inf(-1) || b.Value == math.Inf(-1) {
 		return (a.Value == b.Value && a.Error == b.Error)
 	}
+	if a.Error == math.Inf(1) || b.Error == math.Inf(1) {
+		if a.Error != b.Error {
+			return false
+		}
+		a.Error, b.Error = 0, 0
+	}
 	threshold := 0.000000000001
 
 	ok := true
@@ -24,45 +30,3 @@ func almostEqual(a, b Uncertain) bool {
 	}
 	return ok
 }
-
-/*
-func TestAcosh(t *testing.T) {
-
-}
-*/
-
-/*
-func TestAsinh(t *testing.T) {
-
-}
-*/
-
-/*
-// TODO
-func TestAtanh(t *testing.T) {
-}
-*/
-
-// TODO
-//func Cosh(t *testing.T) {
-
-// TODO
-//func Exp(t *testing.T) {
-
-// TODO
-//func Exp2(t *testing.T) {
-
-// TODO
-//func Log(t *testing.T) {
-
-// TODO
-//func Log10(t *testing.T) {
-
-// TODO
-//func Pow10(t *testing.T) {
-
-// TODO
-//func TestSinh(t *testing.T) {
-
-// TODO
-//func TestTanh(t *testing.T) {
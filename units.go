@@ -0,0 +1,373 @@
+package uncertain
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// prefixes are SI prefixes, "da" goes before "d" so that it is tried first.
+var prefixes = []struct {
+	symbol string
+	factor float64
+}{
+	{"Q", 1e30}, {"R", 1e27}, {"Y", 1e24}, {"Z", 1e21}, {"E", 1e18}, {"P", 1e15}, {"T", 1e12},
+	{"G", 1e9}, {"M", 1e6}, {"k", 1e3}, {"h", 1e2}, {"da", 1e1},
+	{"d", 1e-1}, {"c", 1e-2}, {"m", 1e-3}, {"µ", 1e-6}, {"μ", 1e-6}, {"u", 1e-6}, {"n", 1e-9},
+	{"p", 1e-12}, {"f", 1e-15}, {"a", 1e-18}, {"z", 1e-21}, {"y", 1e-24}, {"r", 1e-27}, {"q", 1e-30},
+}
+
+// registeredUnit is a unit in the registry.
+type registeredUnit struct {
+	unit       Unit
+	prefixable bool
+}
+
+// registry contains units known to LookupUnit, ParseUnit and ParseQuantity.
+var registry = struct {
+	sync.RWMutex
+	units map[string]registeredUnit
+}{units: map[string]registeredUnit{}}
+
+func init() {
+	var (
+		newton = Dimension{Length: 1, Mass: 1, Time: -2}
+		joule  = Dimension{Length: 2, Mass: 1, Time: -2}
+		watt   = Dimension{Length: 2, Mass: 1, Time: -3}
+		pascal = Dimension{Length: -1, Mass: 1, Time: -2}
+		volt   = Dimension{Length: 2, Mass: 1, Time: -3, Current: -1}
+		ohm    = Dimension{Length: 2, Mass: 1, Time: -3, Current: -2}
+		weber  = Dimension{Length: 2, Mass: 1, Time: -2, Current: -1}
+		gray   = Dimension{Length: 2, Time: -2}
+		lumen  = Dimension{Luminosity: 1, Angle: 2}
+	)
+
+	units := []struct {
+		name       string
+		dim        Dimension
+		scale      float64
+		prefixable bool
+	}{
+		// SI base units, kilogram is the prefixed gram
+		{"m", Metre.Dim, 1, true},
+		{"g", Kilogram.Dim, 1e-3, true},
+		{"s", Second.Dim, 1, true},
+		{"A", Ampere.Dim, 1, true},
+		{"K", Kelvin.Dim, 1, true},
+		{"mol", Mole.Dim, 1, true},
+		{"cd", Candela.Dim, 1, true},
+
+		// SI derived units
+		{"rad", Radian.Dim, 1, true},
+		{"sr", Dimension{Angle: 2}, 1, true},
+		{"Hz", Dimension{Time: -1}, 1, true},
+		{"N", newton, 1, true},
+		{"Pa", pascal, 1, true},
+		{"J", joule, 1, true},
+		{"W", watt, 1, true},
+		{"C", Dimension{Time: 1, Current: 1}, 1, true},
+		{"V", volt, 1, true},
+		{"F", Dimension{Length: -2, Mass: -1, Time: 4, Current: 2}, 1, true},
+		{"Ω", ohm, 1, true},
+		{"ohm", ohm, 1, true},
+		{"S", Dimension{}.Div(ohm), 1, true},
+		{"Wb", weber, 1, true},
+		{"T", Dimension{Mass: 1, Time: -2, Current: -1}, 1, true},
+		{"H", Dimension{Length: 2, Mass: 1, Time: -2, Current: -2}, 1, true},
+		{"lm", lumen, 1, true},
+		{"lx", lumen.Div(Dimension{Length: 2}), 1, true},
+		{"Bq", Dimension{Time: -1}, 1, true},
+		{"Gy", gray, 1, true},
+		{"Sv", gray, 1, true},
+		{"kat", Dimension{Amount: 1, Time: -1}, 1, true},
+
+		// Non-SI units
+		{"min", Second.Dim, 60, false},
+		{"h", Second.Dim, 3600, false},
+		{"d", Second.Dim, 86400, false},
+		{"°", Radian.Dim, math.Pi / 180, false},
+		{"deg", Radian.Dim, math.Pi / 180, false},
+		{"L", Dimension{Length: 3}, 1e-3, true},
+		{"l", Dimension{Length: 3}, 1e-3, true},
+		{"Å", Metre.Dim, 1e-10, false},
+		{"in", Metre.Dim, 0.0254, false},
+		{"ft", Metre.Dim, 0.3048, false},
+		{"yd", Metre.Dim, 0.9144, false},
+		{"mi", Metre.Dim, 1609.344, false},
+		{"lb", Kilogram.Dim, 0.45359237, false},
+		{"eV", joule, 1.602176634e-19, true},
+		{"cal", joule, 4.184, true},
+		{"bar", pascal, 1e5, true},
+		{"atm", pascal, 101325, false},
+		{"mmHg", pascal, 133.322387415, false},
+	}
+
+	for _, u := range units {
+		registry.units[u.name] = registeredUnit{Unit{Name: u.name, Dim: u.dim, Scale: u.scale}, u.prefixable}
+	}
+
+	registry.units["°C"] = registeredUnit{Unit{Name: "°C", Dim: Kelvin.Dim, Scale: 1, Offset: 273.15}, false}
+	registry.units["°F"] = registeredUnit{Unit{Name: "°F", Dim: Kelvin.Dim, Scale: 5.0 / 9, Offset: 459.67 * 5 / 9}, false}
+}
+
+// RegisterUnit adds a custom unit to the registry, so that it is known to LookupUnit, ParseUnit and ParseQuantity.
+// If prefixable is true, the unit can be used with SI prefixes, e.g. "kfoo" for a unit "foo".
+//
+// The name of the unit must not be registered yet and must not contain spaces, digits and operators "*·/^()".
+func RegisterUnit(u Unit, prefixable bool) error {
+	if u.Name == "" || strings.IndexFunc(u.Name, isUnitDelimiter) >= 0 || strings.ContainsFunc(u.Name, unicode.IsDigit) {
+		return fmt.Errorf("uncertain: invalid unit name %q", u.Name)
+	}
+	if u.Scale == 0 || math.IsNaN(u.Scale) || math.IsInf(u.Scale, 0) {
+		return fmt.Errorf("uncertain: invalid scale %g of unit %q", u.Scale, u.Name)
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	if _, ok := registry.units[u.Name]; ok {
+		return fmt.Errorf("uncertain: unit %q is already registered", u.Name)
+	}
+	registry.units[u.Name] = registeredUnit{u, prefixable}
+	return nil
+}
+
+// LookupUnit returns a registered unit by its symbol, possibly with an SI prefix, e.g. "km" or "µs".
+// Symbols without a prefix take precedence, e.g. "h" is hour and "cd" is candela.
+func LookupUnit(symbol string) (Unit, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	if r, ok := registry.units[symbol]; ok {
+		return r.unit, true
+	}
+
+	for _, prefix := range prefixes {
+		rest, ok := strings.CutPrefix(symbol, prefix.symbol)
+		if !ok {
+			continue
+		}
+		if r, ok := registry.units[rest]; ok && r.prefixable {
+			u := r.unit
+			u.Name = symbol
+			u.Scale *= prefix.factor
+			return u, true
+		}
+	}
+	return Unit{}, false
+}
+
+// ParseUnit parses a unit expression, e.g. "m/s^2", "kg·m²/s²", "N m" or "J/(mol·K)".
+//
+// An expression consists of registered units (see LookupUnit) with optional integer exponents "^-1" or "⁻¹",
+// multiplied by "*", "·" or a space, divided by "/", and grouped by parentheses.
+// "1" is a dimensionless unit, e.g. "1/s", an empty string is dimensionless too.
+// The returned error is a *ParseError.
+func ParseUnit(s string) (Unit, error) {
+	name := strings.TrimSpace(s)
+	if name == "" {
+		return One, nil
+	}
+
+	// A single registered unit keeps its offset, e.g. "°C"
+	if u, ok := LookupUnit(name); ok {
+		return u, nil
+	}
+
+	p := unitParser{input: s, s: name}
+	u, err := p.expression()
+	if err != nil {
+		return Unit{}, err
+	}
+	if p.pos < len(p.s) {
+		return Unit{}, parseError(s, "unexpected %q in unit", p.s[p.pos:])
+	}
+
+	u.Name = name
+	return u, nil
+}
+
+// unitParser is a recursive descent parser of unit expressions.
+type unitParser struct {
+	input string // the input string for error messages
+	s     string // the trimmed input
+	pos   int    // current position in s
+}
+
+// isUnitDelimiter reports whether r can't be a part of a unit symbol.
+func isUnitDelimiter(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune("*·/^()", r) || strings.ContainsRune(superscripts, r)
+}
+
+// superscripts are exponent characters, digits have indices of their values.
+const superscripts = "⁰¹²³⁴⁵⁶⁷⁸⁹⁻⁺"
+
+// peek returns the next rune, or utf8.RuneError at the end of input.
+func (p *unitParser) peek() rune {
+	r, _ := utf8.DecodeRuneInString(p.s[p.pos:])
+	return r
+}
+
+// skipSpaces skips spaces and reports whether there were any.
+func (p *unitParser) skipSpaces() bool {
+	start := p.pos
+	p.pos += len(p.s[p.pos:]) - len(strings.TrimLeftFunc(p.s[p.pos:], unicode.IsSpace))
+	return p.pos > start
+}
+
+// expression parses terms joined by multiplication and division.
+func (p *unitParser) expression() (Unit, error) {
+	u, err := p.term()
+	if err != nil {
+		return Unit{}, err
+	}
+
+	for {
+		spaces := p.skipSpaces()
+		if p.pos == len(p.s) || p.peek() == ')' {
+			return u, nil
+		}
+
+		div := false
+		switch r := p.peek(); r {
+		case '*', '·', '/':
+			div = r == '/'
+			p.pos += utf8.RuneLen(r)
+		default:
+			if !spaces {
+				return Unit{}, parseError(p.input, "unexpected %q in unit", p.s[p.pos:])
+			}
+		}
+
+		t, err := p.term()
+		if err != nil {
+			return Unit{}, err
+		}
+		if div {
+			u = u.Div(t)
+		} else {
+			u = u.Mul(t)
+		}
+	}
+}
+
+// term parses a unit symbol or an expression in parentheses with an optional exponent.
+func (p *unitParser) term() (u Unit, err error) {
+	p.skipSpaces()
+
+	if p.peek() == '(' {
+		p.pos++
+		if u, err = p.expression(); err != nil {
+			return Unit{}, err
+		}
+		if p.peek() != ')' {
+			return Unit{}, parseError(p.input, "missing closing parenthesis in unit")
+		}
+		p.pos++
+	} else {
+		start := p.pos
+		for p.pos < len(p.s) && !isUnitDelimiter(p.peek()) {
+			p.pos += utf8.RuneLen(p.peek())
+		}
+
+		symbol := p.s[start:p.pos]
+		switch symbol {
+		case "":
+			return Unit{}, parseError(p.input, "missing unit at %q", p.s[start:])
+		case "1":
+			u = One
+		default:
+			var ok bool
+			if u, ok = LookupUnit(symbol); !ok {
+				return Unit{}, parseError(p.input, "unknown unit %q", symbol)
+			}
+		}
+	}
+
+	exp, ok, err := p.exponent()
+	if err != nil || !ok {
+		return u, err
+	}
+	if u, ok = u.pow(float64(exp)); !ok {
+		return Unit{}, parseError(p.input, "too large exponent %d in unit", exp)
+	}
+	return u, nil
+}
+
+// exponent parses an optional integer exponent "^-2" or "⁻²".
+func (p *unitParser) exponent() (exp int, ok bool, err error) {
+	if p.peek() == '^' {
+		p.pos++
+		start := p.pos
+		if r := p.peek(); r == '-' || r == '+' {
+			p.pos++
+		}
+		for p.pos < len(p.s) && '0' <= p.s[p.pos] && p.s[p.pos] <= '9' {
+			p.pos++
+		}
+		if exp, err = strconv.Atoi(p.s[start:p.pos]); err != nil {
+			return 0, false, parseError(p.input, "invalid exponent %q in unit", p.s[start:p.pos])
+		}
+		return exp, true, nil
+	}
+
+	sign, signed, digits := 1, false, 0
+	for p.pos < len(p.s) {
+		r := p.peek()
+		i := strings.IndexRune(superscripts, r)
+		if i < 0 {
+			break
+		}
+		switch digit := utf8.RuneCountInString(superscripts[:i]); {
+		case digit < 10:
+			exp = exp*10 + digit
+			digits++
+		case digits > 0 || signed:
+			return 0, false, parseError(p.input, "invalid exponent in unit")
+		case r == '⁻':
+			sign, signed = -1, true
+		default:
+			signed = true
+		}
+		p.pos += utf8.RuneLen(r)
+	}
+	if signed && digits == 0 {
+		return 0, false, parseError(p.input, "invalid exponent in unit")
+	}
+	return sign * exp, digits > 0, nil
+}
+
+// ParseQuantity parses a value in any notation accepted by Parse followed by a unit expression accepted by ParseUnit,
+// e.g. "9.81 ± 0.02 m/s^2" or "3.3(1) kΩ". The returned error is a *ParseError.
+func ParseQuantity(s string) (Quantity, error) {
+	var unitErr error
+
+	// The longest prefix of s which is a valid value
+	for i := len(s); i > 0; i-- {
+		if i < len(s) && !utf8.RuneStart(s[i]) {
+			continue
+		}
+
+		v, err := Parse(s[:i])
+		if err != nil {
+			continue
+		}
+		u, err := ParseUnit(s[i:])
+		if err == nil {
+			return Quantity{v, u}, nil
+		}
+		if unitErr == nil {
+			unitErr = err
+		}
+	}
+
+	if unitErr != nil {
+		return Quantity{}, &ParseError{s, unitErr.(*ParseError).Msg}
+	}
+	_, err := Parse(s)
+	return Quantity{}, err
+}
@@ -0,0 +1,182 @@
+package uncertain
+
+import (
+	"math"
+	"testing"
+)
+
+// unregisterUnit removes a unit registered by RegisterUnit.
+func unregisterUnit(name string) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	delete(registry.units, name)
+}
+
+func TestLookupUnit(t *testing.T) {
+	cases := []struct {
+		symbol string
+		dim    Dimension
+		scale  float64
+	}{
+		{"m", Metre.Dim, 1},
+		{"km", Metre.Dim, 1e3},
+		{"kg", Kilogram.Dim, 1},
+		{"mg", Kilogram.Dim, 1e-6},
+		{"µs", Second.Dim, 1e-6},
+		{"us", Second.Dim, 1e-6},
+		{"dam", Metre.Dim, 10},
+		{"h", Second.Dim, 3600},
+		{"hPa", Dimension{Length: -1, Mass: 1, Time: -2}, 100},
+		{"cd", Candela.Dim, 1},
+		{"kΩ", Dimension{Length: 2, Mass: 1, Time: -3, Current: -2}, 1e3},
+		{"MeV", Dimension{Length: 2, Mass: 1, Time: -2}, 1.602176634e-13},
+		{"in", Metre.Dim, 0.0254},
+		{"atm", Dimension{Length: -1, Mass: 1, Time: -2}, 101325},
+	}
+
+	for i, the_case := range cases {
+		u, ok := LookupUnit(the_case.symbol)
+		if !ok || u.Name != the_case.symbol || u.Dim != the_case.dim || math.Abs(u.Scale/the_case.scale-1) > 1e-15 {
+			t.Fatalf("Test case %d failed: %q is %v×%g, got %v×%g (%v)", i, the_case.symbol, the_case.dim, the_case.scale, u.Dim, u.Scale, ok)
+		}
+	}
+
+	for _, symbol := range []string{"", "xyz", "kin", "katm", "kkg", "k"} {
+		if u, ok := LookupUnit(symbol); ok {
+			t.Fatalf("%q is not a unit, got %v", symbol, u)
+		}
+	}
+}
+
+func TestParseUnit(t *testing.T) {
+	cases := []struct {
+		s     string
+		dim   Dimension
+		scale float64
+	}{
+		{"", Dimension{}, 1},
+		{"m/s^2", Dimension{Length: 1, Time: -2}, 1},
+		{"m·s⁻²", Dimension{Length: 1, Time: -2}, 1},
+		{"kg*m^2/s^2", Dimension{Length: 2, Mass: 1, Time: -2}, 1},
+		{"kg·m²/s²", Dimension{Length: 2, Mass: 1, Time: -2}, 1},
+		{"m⁺²", Dimension{Length: 2}, 1},
+		{"N m", Dimension{Length: 2, Mass: 1, Time: -2}, 1},
+		{"J/(mol·K)", Dimension{Length: 2, Mass: 1, Time: -2, Temperature: -1, Amount: -1}, 1},
+		{"J/mol/K", Dimension{Length: 2, Mass: 1, Time: -2, Temperature: -1, Amount: -1}, 1},
+		{"1/s", Dimension{Time: -1}, 1},
+		{"km/h", Dimension{Length: 1, Time: -1}, 1 / 3.6},
+		{"(mm)^3", Dimension{Length: 3}, 1e-9},
+		{" mm^-1 ", Dimension{Length: -1}, 1e3},
+	}
+
+	for i, the_case := range cases {
+		u, err := ParseUnit(the_case.s)
+		if err != nil || u.Dim != the_case.dim || math.Abs(u.Scale/the_case.scale-1) > 1e-15 {
+			t.Fatalf("Test case %d failed: %q is %v×%g, got %v×%g (%v)", i, the_case.s, the_case.dim, the_case.scale, u.Dim, u.Scale, err)
+		}
+	}
+
+	errors := []struct {
+		s   string
+		msg string
+	}{
+		{"xyz", `uncertain: parsing "xyz": unknown unit "xyz"`},
+		{"m/", `uncertain: parsing "m/": missing unit at ""`},
+		{"m^", `uncertain: parsing "m^": invalid exponent "" in unit`},
+		{"(m/s", `uncertain: parsing "(m/s": missing closing parenthesis in unit`},
+		{"m)", `uncertain: parsing "m)": unexpected ")" in unit`},
+		{"m⁻", `uncertain: parsing "m⁻": invalid exponent in unit`},
+		{"m⁺", `uncertain: parsing "m⁺": invalid exponent in unit`},
+		{"m⁺⁻²", `uncertain: parsing "m⁺⁻²": invalid exponent in unit`},
+		{"m²⁺", `uncertain: parsing "m²⁺": invalid exponent in unit`},
+	}
+
+	for i, the_case := range errors {
+		if _, err := ParseUnit(the_case.s); err == nil || err.Error() != the_case.msg {
+			t.Fatalf("Test case %d failed: error for %q is %q, got %v", i, the_case.s, the_case.msg, err)
+		}
+	}
+}
+
+func TestParseQuantity(t *testing.T) {
+	cases := []struct {
+		s    string
+		v    Uncertain
+		unit string
+		dim  Dimension
+	}{
+		{"9.81 ± 0.02 m/s^2", Uncertain{9.81, 0.02}, "m/s^2", Dimension{Length: 1, Time: -2}},
+		{"3.3(1) kΩ", Uncertain{3.3, 0.1}, "kΩ", Dimension{Length: 2, Mass: 1, Time: -3, Current: -2}},
+		{"(1.23 ± 0.05)e3 eV", Uncertain{1230, 50}, "eV", Dimension{Length: 2, Mass: 1, Time: -2}},
+		{"1.25 ± 4% V", Uncertain{1.25, 0.05}, "V", Dimension{Length: 2, Mass: 1, Time: -3, Current: -1}},
+		{"25 mm", Uncertain{25, 0}, "mm", Metre.Dim},
+		{"1e3m", Uncertain{1000, 0}, "m", Metre.Dim},
+		{"20.0 ± 0.5 °C", Uncertain{20, 0.5}, "°C", Kelvin.Dim},
+		{"0.5", Uncertain{0.5, 0}, "", Dimension{}},
+	}
+
+	for i, the_case := range cases {
+		q, err := ParseQuantity(the_case.s)
+		if err != nil || !almostEqual(q.Uncertain, the_case.v) || q.Unit.Name != the_case.unit || q.Unit.Dim != the_case.dim {
+			t.Fatalf("Test case %d failed: %q is %v %s, got %v (%v)", i, the_case.s, the_case.v, the_case.unit, q, err)
+		}
+	}
+
+	errors := []struct {
+		s   string
+		msg string
+	}{
+		{"9.81 ± 0.02 m/xyz", `uncertain: parsing "9.81 ± 0.02 m/xyz": unknown unit "xyz"`},
+		{"abc m", `uncertain: parsing "abc m": invalid value "abc m"`},
+	}
+
+	for i, the_case := range errors {
+		if _, err := ParseQuantity(the_case.s); err == nil || err.Error() != the_case.msg {
+			t.Fatalf("Test case %d failed: error for %q is %q, got %v", i, the_case.s, the_case.msg, err)
+		}
+	}
+}
+
+func TestTemperatureUnits(t *testing.T) {
+	celsius, _ := LookupUnit("°C")
+	fahrenheit, _ := LookupUnit("°F")
+
+	q, err := NewQuantity(Uncertain{20, 0.5}, celsius).Convert(Kelvin)
+	if err != nil || !almostEqual(q.Uncertain, Uncertain{293.15, 0.5}) {
+		t.Fatalf("20±0.5 °C is 293.15±0.5 K, got %v (%v)", q, err)
+	}
+
+	q, err = NewQuantity(Uncertain{100, 1}, celsius).Convert(fahrenheit)
+	if err != nil || !almostEqual(q.Uncertain, Uncertain{212, 1.8}) {
+		t.Fatalf("100±1 °C is 212±1.8 °F, got %v (%v)", q, err)
+	}
+
+	if si := NewQuantity(Uncertain{32, 1}, fahrenheit).ToSI(); !almostEqual(si.Uncertain, Uncertain{273.15, 5.0 / 9}) {
+		t.Fatalf("32±1 °F is 273.15±0.56 K, got %v", si)
+	}
+
+	sum, err := NewQuantity(Uncertain{20, 0.5}, celsius).Add(NewQuantity(Uncertain{5, 0.1}, Kelvin))
+	if err != nil || !almostEqual(sum.Uncertain, Uncertain{25, 0.6}) {
+		t.Fatalf("20±0.5 °C + 5±0.1 K is 25±0.6 °C, got %v (%v)", sum, err)
+	}
+}
+
+func TestRegisterUnit(t *testing.T) {
+	furlong := Unit{Name: "furlong", Dim: Metre.Dim, Scale: 201.168}
+	if err := RegisterUnit(furlong, true); err != nil {
+		t.Fatalf("Can't register furlong: %v", err)
+	}
+	t.Cleanup(func() { unregisterUnit(furlong.Name) })
+
+	q, err := ParseQuantity("2.5 ± 0.1 kfurlong/h")
+	if err != nil || q.Unit.Dim != (Dimension{Length: 1, Time: -1}) || math.Abs(q.Unit.Scale-201.168/3.6) > 1e-12 {
+		t.Fatalf("kfurlong/h is 55.88 m/s, got %v×%g (%v)", q.Unit.Dim, q.Unit.Scale, err)
+	}
+
+	for _, u := range []Unit{furlong, {Name: "m", Dim: Metre.Dim, Scale: 1}, {Name: "a b", Scale: 1}, {Name: "x2", Scale: 1}, {Name: "", Scale: 1}, {Name: "zero"}} {
+		if err := RegisterUnit(u, false); err == nil {
+			t.Fatalf("%#v is registered without error", u)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package uncertain
+
+import (
+	"slices"
+	"sync/atomic"
+)
+
+// Var is an uncertain value that tracks its linear dependence on independent sources of error.
+//
+// Every source created by NewVar has a unique ID. Operations on Var record, for every source,
+// the contribution of the source's error to the error of the result, i.e. the derivative of the result
+// with respect to the source multiplied by the source's error. Correlated terms cancel correctly:
+//
+//	x := NewVar(Uncertain{10, 1})
+//	x.Sub(x).Uncertain() // {0, 0}, while Uncertain{10, 1}.Sub(Uncertain{10, 1}) is {0, 2}
+//
+// The error of a Var is calculated from all contributions only when it is converted to Uncertain.
+// Contributions are propagated as gradients of Dual numbers, so derivatives of functions are those of Dual.
+//
+// Var uses first-order derivatives only, so there are no interval-based special cases like in Sqrt or Acos:
+// where a derivative is infinite, the error is infinite too.
+//
+// A Var with nil contributions, e.g. Var{Value: 2}, is an exact value.
+type Var struct {
+	Value float64
+
+	parts map[uint64]float64 // error contributions by source ID
+}
+
+var lastSourceID atomic.Uint64
+
+// NewVar returns a new independent source of error with the value and the error of v.
+// If v.Error is zero, the result is an exact value.
+func NewVar(v Uncertain) Var {
+	if v.Error == 0 {
+		return Var{Value: v.Value}
+	}
+	id := lastSourceID.Add(1)
+	return Var{Value: v.Value, parts: map[uint64]float64{id: v.Error}}
+}
+
+// Uncertain returns the value and the error of v. Error is combined from contributions of all sources by Linear propagator.
+func (v Var) Uncertain() Uncertain {
+	return v.UncertainWith(Linear{})
+}
+
+// UncertainWith returns the value and the error of v. Error is combined from contributions of all sources by propagator p.
+func (v Var) UncertainWith(p Propagator) Uncertain {
+	contributions := make([]float64, 0, len(v.parts))
+	for _, id := range v.Sources() {
+		contributions = append(contributions, v.parts[id])
+	}
+	return Uncertain{v.Value, p.Combine(contributions...)}
+}
+
+// Sources returns sorted IDs of the sources v depends on.
+func (v Var) Sources() []uint64 {
+	ids := make([]uint64, 0, len(v.parts))
+	for id := range v.parts {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// Contribution returns the signed contribution of the source with the given ID to the error of v.
+// It is zero if v doesn't depend on the source.
+func (v Var) Contribution(id uint64) float64 {
+	return v.parts[id]
+}
+
+// dual returns v as a Dual with gradient components for the given sources, which must include all sources of v.
+func (v Var) dual(ids []uint64) Dual {
+	d := Dual{v.Value, make([]float64, len(ids))}
+	for i, id := range ids {
+		d.Grad[i] = v.parts[id]
+	}
+	return d
+}
+
+// fromDual returns a Var with the value of d which depends on sources ids with contributions from the gradient of d.
+func fromDual(d Dual, ids []uint64) (result Var) {
+	result.Value = d.Value
+	for i, c := range d.Grad {
+		if c == 0 {
+			continue
+		}
+		if result.parts == nil {
+			result.parts = make(map[uint64]float64, len(ids))
+		}
+		result.parts[ids[i]] = c
+	}
+	return
+}
+
+// unary returns the result of a Dual function f of v.
+// Contributions are linear in errors of sources, so they are propagated as gradients of Duals.
+func (v Var) unary(f func(Dual) Dual) Var {
+	ids := v.Sources()
+	return fromDual(f(v.dual(ids)), ids)
+}
+
+// binaryVar returns the result of a Dual function f of v1 and v2.
+func binaryVar(v1, v2 Var, f func(Dual, Dual) Dual) Var {
+	ids := append(v1.Sources(), v2.Sources()...)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+	return fromDual(f(v1.dual(ids), v2.dual(ids)), ids)
+}
+
+// Add returns the sum of v1 and v2.
+func (v1 Var) Add(v2 Var) Var {
+	return binaryVar(v1, v2, Dual.Add)
+}
+
+// Sub returns the difference between v1 and v2.
+func (v1 Var) Sub(v2 Var) Var {
+	return binaryVar(v1, v2, Dual.Sub)
+}
+
+// Mul returns the product of v1 and v2.
+func (v1 Var) Mul(v2 Var) Var {
+	return binaryVar(v1, v2, Dual.Mul)
+}
+
+// Div returns the quotient of dividend v1 and divisor v2.
+func (v1 Var) Div(v2 Var) Var {
+	return binaryVar(v1, v2, Dual.Div)
+}
+
+// Sqrt returns the square root of v.
+func (v Var) Sqrt() Var {
+	return v.unary(Dual.Sqrt)
+}
+
+// Sin returns the sine of the radian argument v.
+func (v Var) Sin() Var {
+	return v.unary(Dual.Sin)
+}
+
+// Cos returns the cosine of the radian argument v.
+func (v Var) Cos() Var {
+	return v.unary(Dual.Cos)
+}
+
+// Tan returns the tangent of the radian argument v.
+func (v Var) Tan() Var {
+	return v.unary(Dual.Tan)
+}
+
+// Asin returns the arcsine, in radians, of v.
+func (v Var) Asin() Var {
+	return v.unary(Dual.Asin)
+}
+
+// Acos returns the arccosine, in radians, of v.
+func (v Var) Acos() Var {
+	return v.unary(Dual.Acos)
+}
+
+// Atan returns the arctangent, in radians, of v.
+func (v Var) Atan() Var {
+	return v.unary(Dual.Atan)
+}
+
+// Atan2 returns the arc tangent of y/x, using the signs of the two to determine the quadrant of the return value.
+func (y Var) Atan2(x Var) Var {
+	return binaryVar(y, x, Dual.Atan2)
+}
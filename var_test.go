@@ -0,0 +1,123 @@
+package uncertain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVarCorrelated(t *testing.T) {
+	x := NewVar(Uncertain{10, 1})
+	y := NewVar(Uncertain{5, 0.5})
+
+	cases := []struct {
+		name string
+		v    Var
+		res  Uncertain
+	}{
+		{"x", x, Uncertain{10, 1}},
+		{"x-x", x.Sub(x), Uncertain{0, 0}},
+		{"x+x", x.Add(x), Uncertain{20, 2}},
+		{"x/x", x.Div(x), Uncertain{1, 0}},
+		{"x*x", x.Mul(x), Uncertain{100, 20}},
+		{"x+y", x.Add(y), Uncertain{15, 1.5}},
+		{"x-y", x.Sub(y), Uncertain{5, 1.5}},
+		{"x*y", x.Mul(y), Uncertain{50, 10}},
+		{"x/y", x.Div(y), Uncertain{2, 0.4}},
+		{"(x+y)-y", x.Add(y).Sub(y), Uncertain{10, 1}},
+		{"x*y/x", x.Mul(y).Div(x), Uncertain{5, 0.5}},
+		{"2*x-x", Var{Value: 2}.Mul(x).Sub(x), Uncertain{10, 1}},
+		{"sin²x+cos²x", x.Sin().Mul(x.Sin()).Add(x.Cos().Mul(x.Cos())), Uncertain{1, 0}},
+		{"sqrt(x*x)", x.Mul(x).Sqrt(), Uncertain{10, 1}},
+		{"atan(tan(x/10))", x.Div(Var{Value: 10}).Tan().Atan(), Uncertain{1, 0.1}},
+		{"asin(sin(x/20))", x.Div(Var{Value: 20}).Sin().Asin(), Uncertain{0.5, 0.05}},
+		{"acos(cos(x/20))", x.Div(Var{Value: 20}).Cos().Acos(), Uncertain{0.5, 0.05}},
+		{"atan2(x, x)", x.Atan2(x), Uncertain{math.Pi / 4, 0}},
+	}
+
+	for i, the_case := range cases {
+		res := the_case.v.Uncertain()
+
+		if !almostEqual(res, the_case.res) {
+			t.Fatalf("Test case %d failed: %s is %f±%f, got %f±%f",
+				i, the_case.name, the_case.res.Value, the_case.res.Error, res.Value, res.Error)
+		}
+	}
+}
+
+func TestVarMatchesUncertain(t *testing.T) {
+	values := []Uncertain{
+		{0.1, 0.01}, {-0.5, 0.1}, {0.75, 0.2}, {2, 0.05}, {9, 0.3},
+	}
+
+	for i, v := range values {
+		x := NewVar(v)
+
+		pairs := []struct {
+			name     string
+			got, exp Uncertain
+		}{
+			{"Sqrt", x.Sqrt().Uncertain(), Sqrt(v)},
+			{"Sin", x.Sin().Uncertain(), Sin(v)},
+			{"Cos", x.Cos().Uncertain(), Cos(v)},
+			{"Tan", x.Tan().Uncertain(), Tan(v)},
+			{"Asin", x.Asin().Uncertain(), Asin(v)},
+			{"Acos", x.Acos().Uncertain(), Acos(v)},
+			{"Atan", x.Atan().Uncertain(), Atan(v)},
+		}
+
+		for _, p := range pairs {
+			if math.IsNaN(p.exp.Value) {
+				if !math.IsNaN(p.got.Value) {
+					t.Fatalf("Test case %d failed: %s(%f±%f) is %f±%f, got %f±%f",
+						i, p.name, v.Value, v.Error, p.exp.Value, p.exp.Error, p.got.Value, p.got.Error)
+				}
+				continue
+			}
+			if !almostEqual(p.got, p.exp) {
+				t.Fatalf("Test case %d failed: %s(%f±%f) is %f±%f, got %f±%f",
+					i, p.name, v.Value, v.Error, p.exp.Value, p.exp.Error, p.got.Value, p.got.Error)
+			}
+		}
+	}
+}
+
+func TestVarUncertainWith(t *testing.T) {
+	x := NewVar(Uncertain{10, 3})
+	y := NewVar(Uncertain{-5, 4})
+
+	res := x.Add(y).UncertainWith(Quadrature{})
+	if !almostEqual(res, Uncertain{5, 5}) {
+		t.Fatalf("Quadrature sum of 10±3 and -5±4 is 5±5, got %f±%f", res.Value, res.Error)
+	}
+
+	res = x.Add(y).Add(x).UncertainWith(Quadrature{})
+	if !almostEqual(res, Uncertain{15, 7.21110255092798}) {
+		t.Fatalf("Quadrature sum of 2*(10±3) and -5±4 is 15±7.211103, got %f±%f", res.Value, res.Error)
+	}
+}
+
+func TestVarSources(t *testing.T) {
+	x := NewVar(Uncertain{10, 1})
+	y := NewVar(Uncertain{5, 0.5})
+	exact := NewVar(Uncertain{3, 0})
+
+	if len(exact.Sources()) != 0 {
+		t.Fatalf("Exact value must have no sources, got %v", exact.Sources())
+	}
+
+	ids := x.Mul(y).Add(exact).Sources()
+	if len(ids) != 2 || ids[0] != x.Sources()[0] || ids[1] != y.Sources()[0] {
+		t.Fatalf("x*y+3 must depend on x and y, got %v", ids)
+	}
+
+	v := x.Mul(y)
+	if c := v.Contribution(x.Sources()[0]); c != 5 {
+		t.Fatalf("Contribution of x to x*y is 5, got %f", c)
+	}
+	if c := v.Contribution(y.Sources()[0]); c != 5 {
+		t.Fatalf("Contribution of y to x*y is 5, got %f", c)
+	}
+	if len(x.Sub(x).Sources()) != 0 {
+		t.Fatalf("x-x must have no sources, got %v", x.Sub(x).Sources())
+	}
+}
@@ -0,0 +1,425 @@
+package uncertain
+
+import (
+	"math"
+	"slices"
+)
+
+// VecN is a vector of uncertain components.
+//
+// If Cov is nil, components are independent and errors are propagated by the Linear rule,
+// as for operations on Uncertain. Otherwise Cov is the covariance matrix of components,
+// errors are standard deviations and are propagated to first order as J·Cov·Jᵀ, where J is the Jacobian of an operation.
+// If any operand of an operation has covariance, the result has covariance too.
+// Different operands are considered independent.
+//
+// Derivatives are calculated by Dual numbers, so that correlations of components inside an operation are taken into account.
+type VecN struct {
+	X   []Uncertain
+	Cov [][]float64
+}
+
+// Vec2 is a two-dimensional vector of independent uncertain components, see VecN.
+type Vec2 [2]Uncertain
+
+// Vec3 is a three-dimensional vector of independent uncertain components, see VecN.
+type Vec3 [3]Uncertain
+
+// vecInputs are components of operands of an operation as Duals.
+type vecInputs struct {
+	x    [][]Dual    // components of every operand
+	errs []float64   // errors of all components
+	cov  [][]float64 // covariance of all components, nil for the Linear rule
+}
+
+// newVecInputs returns components of vectors as Duals with derivatives with respect to all components.
+func newVecInputs(vecs ...VecN) (in vecInputs) {
+	n, withCov := 0, false
+	for _, v := range vecs {
+		n += len(v.X)
+		withCov = withCov || v.Cov != nil
+	}
+
+	in.x = make([][]Dual, len(vecs))
+	in.errs = make([]float64, 0, n)
+	if withCov {
+		in.cov = make([][]float64, n)
+		for i := range in.cov {
+			in.cov[i] = make([]float64, n)
+		}
+	}
+
+	for i, v := range vecs {
+		start := len(in.errs)
+		in.x[i] = make([]Dual, len(v.X))
+
+		for j, c := range v.X {
+			in.x[i][j] = Dual{c.Value, make([]float64, n)}
+			in.x[i][j].Grad[start+j] = 1
+			in.errs = append(in.errs, c.Error)
+
+			if in.cov == nil {
+				continue
+			}
+			if v.Cov == nil {
+				in.cov[start+j][start+j] = c.Error * c.Error
+			} else {
+				copy(in.cov[start+j][start:], v.Cov[j])
+			}
+		}
+	}
+	return
+}
+
+// covariance returns the covariance of results with gradients g1 and g2.
+func (in vecInputs) covariance(g1, g2 []float64) (sum float64) {
+	for i, a := range g1 {
+		for j, b := range g2 {
+			if a != 0 && b != 0 && in.cov[i][j] != 0 {
+				sum += a * in.cov[i][j] * b
+			}
+		}
+	}
+	return
+}
+
+// scalar returns a result with the value and the gradient of d, errors are combined by the Linear rule if there is no covariance.
+func (in vecInputs) scalar(d Dual) Uncertain {
+	return in.scalarWith(Linear{}, d)
+}
+
+// scalarWith returns a result with the value and the gradient of d, errors are combined by p if there is no covariance.
+func (in vecInputs) scalarWith(p Propagator, d Dual) Uncertain {
+	if in.cov != nil {
+		return Uncertain{d.Value, math.Sqrt(in.covariance(d.Grad, d.Grad))}
+	}
+
+	contributions := make([]float64, 0, len(d.Grad))
+	for i, g := range d.Grad {
+		if in.errs[i] != 0 {
+			contributions = append(contributions, g*in.errs[i])
+		}
+	}
+	return Uncertain{d.Value, p.Combine(contributions...)}
+}
+
+// vector returns a vector with values and gradients of components d.
+func (in vecInputs) vector(d []Dual) (res VecN) {
+	res.X = make([]Uncertain, len(d))
+	for i := range d {
+		res.X[i] = in.scalar(d[i])
+	}
+
+	if in.cov != nil {
+		res.Cov = make([][]float64, len(d))
+		for i := range d {
+			res.Cov[i] = make([]float64, len(d))
+			for j := range d {
+				res.Cov[i][j] = in.covariance(d[i].Grad, d[j].Grad)
+			}
+		}
+	}
+	return
+}
+
+// sameLength panics if vectors are of different lengths.
+func sameLength(u, v VecN) {
+	if len(u.X) != len(v.X) {
+		panic("uncertain: vectors of different lengths")
+	}
+}
+
+// dualDot returns the dot product of u and v.
+func dualDot(u, v []Dual) Dual {
+	sum := Dual{}
+	for i := range u {
+		sum = sum.Add(u[i].Mul(v[i]))
+	}
+	return sum
+}
+
+// dualNorm returns the Euclidean norm of u without overflow, like math.Hypot.
+// Derivatives at the zero vector are infinite.
+func dualNorm(u []Dual) Dual {
+	r := 0.0
+	for _, c := range u {
+		r = math.Hypot(r, c.Value)
+	}
+
+	norm := Dual{Value: r}
+	for _, c := range u {
+		d := c.Value / r
+		if r == 0 {
+			d = math.Inf(1)
+		}
+		norm = dualLinear(r, norm, 1, c, d)
+	}
+	return norm
+}
+
+// Add returns u + v.
+func (u VecN) Add(v VecN) VecN {
+	sameLength(u, v)
+	in := newVecInputs(u, v)
+	res := make([]Dual, len(u.X))
+	for i := range res {
+		res[i] = in.x[0][i].Add(in.x[1][i])
+	}
+	return in.vector(res)
+}
+
+// Sub returns u - v.
+func (u VecN) Sub(v VecN) VecN {
+	sameLength(u, v)
+	in := newVecInputs(u, v)
+	res := make([]Dual, len(u.X))
+	for i := range res {
+		res[i] = in.x[0][i].Sub(in.x[1][i])
+	}
+	return in.vector(res)
+}
+
+// Scale returns u multiplied by k.
+func (u VecN) Scale(k Uncertain) VecN {
+	in := newVecInputs(u, VecN{X: []Uncertain{k}})
+	res := make([]Dual, len(u.X))
+	for i := range res {
+		res[i] = in.x[0][i].Mul(in.x[1][0])
+	}
+	return in.vector(res)
+}
+
+// Dot returns the dot product of u and v.
+func (u VecN) Dot(v VecN) Uncertain {
+	sameLength(u, v)
+	in := newVecInputs(u, v)
+	return in.scalar(dualDot(in.x[0], in.x[1]))
+}
+
+// Cross returns the cross product of three-dimensional vectors u and v.
+func (u VecN) Cross(v VecN) VecN {
+	if len(u.X) != 3 || len(v.X) != 3 {
+		panic("uncertain: cross product of vectors which are not three-dimensional")
+	}
+	in := newVecInputs(u, v)
+	a, b := in.x[0], in.x[1]
+	return in.vector([]Dual{
+		a[1].Mul(b[2]).Sub(a[2].Mul(b[1])),
+		a[2].Mul(b[0]).Sub(a[0].Mul(b[2])),
+		a[0].Mul(b[1]).Sub(a[1].Mul(b[0])),
+	})
+}
+
+// Norm returns the Euclidean length of u. The derivative with respect to a component x is x/|u|.
+//
+// The norm of the zero vector has no derivative, its error is the largest possible length:
+// the norm of errors for the Linear rule, or the square root of the trace of the covariance.
+func (u VecN) Norm() Uncertain {
+	in := newVecInputs(u)
+	norm := dualNorm(in.x[0])
+	if norm.Value != 0 {
+		return in.scalar(norm)
+	}
+
+	sum := 0.0
+	for i := range u.X {
+		if in.cov != nil {
+			sum += in.cov[i][i]
+		} else {
+			sum += u.X[i].Error * u.X[i].Error
+		}
+	}
+	return Uncertain{0, math.Sqrt(sum)}
+}
+
+// Normalize returns the unit vector in the direction of u.
+//
+// The zero vector has no direction, so special cases are:
+//
+//	Normalize of the exact zero vector has NaN ± 0 components
+//	Normalize of the uncertain zero vector has NaN ± NaN components and NaN covariance, if any
+func (u VecN) Normalize() VecN {
+	in := newVecInputs(u)
+	norm := dualNorm(in.x[0])
+	res := make([]Dual, len(u.X))
+	for i := range res {
+		res[i] = in.x[0][i].Div(norm)
+	}
+	return in.vector(res)
+}
+
+// AngleBetween returns the angle between u and v in the range [0, π].
+//
+// The angle is calculated by Atan2 as 2·atan2(|u·|v| - v·|u||, |u·|v| + v·|u||), which is accurate
+// for all angles, unlike Acos of the normalized dot product near 0 and π.
+//
+// The angle between parallel or opposite vectors has no derivatives: it grows like |δ⊥|/|u|,
+// where δ⊥ is the component of a change of u perpendicular to u, and the same for v.
+// Its error is the norm of perpendicular components of errors divided by the lengths of the vectors:
+// the sum of their lengths by the Linear rule, or the square root of the trace of their covariance.
+// The angle with the zero vector has an infinite error, unless the vectors are exact.
+func (u VecN) AngleBetween(v VecN) Uncertain {
+	sameLength(u, v)
+	in := newVecInputs(u, v)
+	a, b := in.x[0], in.x[1]
+	na, nb := dualNorm(a), dualNorm(b)
+
+	diff, sum := make([]Dual, len(a)), make([]Dual, len(a))
+	for i := range a {
+		p, q := a[i].Mul(nb), b[i].Mul(na)
+		diff[i], sum[i] = p.Sub(q), p.Add(q)
+	}
+
+	y, x := dualNorm(diff), dualNorm(sum)
+	switch {
+	case y.Value == 0 && x.Value == 0:
+		angle := Uncertain{0, math.Inf(1)}
+		if slices.ContainsFunc(in.errs, func(e float64) bool { return e != 0 }) {
+			return angle
+		}
+		angle.Error = 0
+		return angle
+	case y.Value == 0:
+		return Uncertain{0, in.perpendicular(na.Value, nb.Value, -1)}
+	case x.Value == 0:
+		return Uncertain{math.Pi, in.perpendicular(na.Value, nb.Value, 1)}
+	}
+	return in.scalar(y.Atan2(x).Mul(Dual{Value: 2}))
+}
+
+// perpendicular returns the error of the angle between parallel (sign = -1) or opposite (sign = 1) operands
+// of lengths na and nb: the norm of the perpendicular change of direction δu⊥/|u| + sign·δv⊥/|v|.
+//
+// With covariance it is the square root of the trace of the covariance of the change. Otherwise it is the worst case
+// by the Linear rule: the sum of lengths of perpendicular components of errors of all components.
+func (in vecInputs) perpendicular(na, nb, sign float64) float64 {
+	a, n := in.x[0], len(in.x[0])
+
+	if in.cov == nil {
+		contributions := make([]float64, 0, 2*n)
+		for k := range n {
+			// Length of the k-th column of the projection P = I - e·eᵀ onto the plane perpendicular to e = u/|u|
+			e := a[k].Value / na
+			p := math.Sqrt(math.Max(1-e*e, 0))
+			if p != 0 && in.errs[k] != 0 {
+				contributions = append(contributions, p/na*in.errs[k])
+			}
+			if p != 0 && in.errs[n+k] != 0 {
+				contributions = append(contributions, p/nb*in.errs[n+k])
+			}
+		}
+		return Linear{}.Combine(contributions...)
+	}
+
+	variance := 0.0
+	g := make([]float64, 2*n)
+	for r := range n {
+		// Row r of the projection P
+		for k := range n {
+			p := -a[r].Value * a[k].Value / (na * na)
+			if r == k {
+				p++
+			}
+			g[k], g[n+k] = p/na, sign*p/nb
+		}
+		variance += in.covariance(g, g)
+	}
+	return math.Sqrt(variance)
+}
+
+// VecN returns u as VecN with independent components.
+func (u Vec2) VecN() VecN {
+	return VecN{X: u[:]}
+}
+
+// vec2 returns the components of a two-dimensional v.
+func (v VecN) vec2() (res Vec2) {
+	copy(res[:], v.X)
+	return
+}
+
+// Add returns u + v.
+func (u Vec2) Add(v Vec2) Vec2 {
+	return u.VecN().Add(v.VecN()).vec2()
+}
+
+// Sub returns u - v.
+func (u Vec2) Sub(v Vec2) Vec2 {
+	return u.VecN().Sub(v.VecN()).vec2()
+}
+
+// Scale returns u multiplied by k.
+func (u Vec2) Scale(k Uncertain) Vec2 {
+	return u.VecN().Scale(k).vec2()
+}
+
+// Dot returns the dot product of u and v.
+func (u Vec2) Dot(v Vec2) Uncertain {
+	return u.VecN().Dot(v.VecN())
+}
+
+// Norm returns the Euclidean length of u, see VecN.Norm.
+func (u Vec2) Norm() Uncertain {
+	return u.VecN().Norm()
+}
+
+// Normalize returns the unit vector in the direction of u.
+func (u Vec2) Normalize() Vec2 {
+	return u.VecN().Normalize().vec2()
+}
+
+// AngleBetween returns the angle between u and v in the range [0, π], see VecN.AngleBetween.
+func (u Vec2) AngleBetween(v Vec2) Uncertain {
+	return u.VecN().AngleBetween(v.VecN())
+}
+
+// VecN returns u as VecN with independent components.
+func (u Vec3) VecN() VecN {
+	return VecN{X: u[:]}
+}
+
+// vec3 returns the components of a three-dimensional v.
+func (v VecN) vec3() (res Vec3) {
+	copy(res[:], v.X)
+	return
+}
+
+// Add returns u + v.
+func (u Vec3) Add(v Vec3) Vec3 {
+	return u.VecN().Add(v.VecN()).vec3()
+}
+
+// Sub returns u - v.
+func (u Vec3) Sub(v Vec3) Vec3 {
+	return u.VecN().Sub(v.VecN()).vec3()
+}
+
+// Scale returns u multiplied by k.
+func (u Vec3) Scale(k Uncertain) Vec3 {
+	return u.VecN().Scale(k).vec3()
+}
+
+// Dot returns the dot product of u and v.
+func (u Vec3) Dot(v Vec3) Uncertain {
+	return u.VecN().Dot(v.VecN())
+}
+
+// Cross returns the cross product of u and v.
+func (u Vec3) Cross(v Vec3) Vec3 {
+	return u.VecN().Cross(v.VecN()).vec3()
+}
+
+// Norm returns the Euclidean length of u, see VecN.Norm.
+func (u Vec3) Norm() Uncertain {
+	return u.VecN().Norm()
+}
+
+// Normalize returns the unit vector in the direction of u.
+func (u Vec3) Normalize() Vec3 {
+	return u.VecN().Normalize().vec3()
+}
+
+// AngleBetween returns the angle between u and v in the range [0, π], see VecN.AngleBetween.
+func (u Vec3) AngleBetween(v Vec3) Uncertain {
+	return u.VecN().AngleBetween(v.VecN())
+}
@@ -0,0 +1,145 @@
+package uncertain
+
+import (
+	"math"
+	"testing"
+)
+
+func TestVectorOperations(t *testing.T) {
+	u := Vec3{{1, 0.1}, {2, 0.1}, {3, 0.1}}
+	v := Vec3{{4, 0}, {5, 0}, {6, 0}}
+
+	sum := u.Add(v)
+	if sum != (Vec3{{5, 0.1}, {7, 0.1}, {9, 0.1}}) {
+		t.Fatalf("Sum is wrong: %v", sum)
+	}
+	if diff := v.Sub(u); diff != (Vec3{{3, 0.1}, {3, 0.1}, {3, 0.1}}) {
+		t.Fatalf("Difference is wrong: %v", diff)
+	}
+	if scaled := u.Scale(Uncertain{2, 0.5}); !almostEqual(scaled[2], Uncertain{6, 1.7}) {
+		t.Fatalf("Component of (1±0.1, 2±0.1, 3±0.1)·(2±0.5) is 6±1.7, got %v", scaled[2])
+	}
+	if dot := u.Dot(v); !almostEqual(dot, Uncertain{32, 1.5}) {
+		t.Fatalf("Dot product is 32±1.5, got %v", dot)
+	}
+
+	x, y := Vec3{{1, 0.1}, {0, 0}, {0, 0}}, Vec3{{0, 0}, {1, 0.2}, {0, 0}}
+	if z := x.Cross(y); z[0] != (Uncertain{0, 0}) || z[1] != (Uncertain{0, 0}) || !almostEqual(z[2], Uncertain{1, 0.3}) {
+		t.Fatalf("Cross product of x and y is (0, 0, 1±0.3), got %v", z)
+	}
+
+	w := Vec2{{3, 0.1}, {4, 0.2}}
+	if norm := w.Norm(); !almostEqual(norm, Uncertain{5, 0.22}) {
+		t.Fatalf("Norm of (3±0.1, 4±0.2) is 5±0.22, got %v", norm)
+	}
+	if norm := (Vec2{{0, 0.3}, {0, 0.4}}).Norm(); norm != (Uncertain{0, 0.5}) {
+		t.Fatalf("Norm of (0±0.3, 0±0.4) is 0±0.5, got %v", norm)
+	}
+	if norm := (VecN{X: []Uncertain{{3e200, 1e199}, {4e200, 0}}}).Norm(); !almostEqual(norm, Uncertain{5e200, 0.6e199}) {
+		t.Fatalf("Norm of (3e200±1e199, 4e200) is 5e200±6e198, got %v", norm)
+	}
+
+	n := w.Normalize()
+	if !almostEqual(n[0], Uncertain{0.6, 0.032}) || !almostEqual(n[1], Uncertain{0.8, 0.024}) {
+		t.Fatalf("Normalized (3±0.1, 4±0.2) is (0.6±0.032, 0.8±0.024), got %v", n)
+	}
+}
+
+func TestAngleBetween(t *testing.T) {
+	angle := Vec2{{1, 0}, {0, 0}}.AngleBetween(Vec2{{0, 0.1}, {1, 0}})
+	if !almostEqual(angle, Uncertain{math.Pi / 2, 0.1}) {
+		t.Fatalf("Angle between (1, 0) and (0±0.1, 1) is π/2±0.1, got %v", angle)
+	}
+
+	u := Vec3{{1, 0.01}, {2, 0.02}, {2, 0.03}}
+	v := Vec3{{3, 0.01}, {-1, 0.02}, {0.5, 0.01}}
+
+	acos := Eval(func(x ...Dual) Dual {
+		a, b := x[:3], x[3:]
+		return dualDot(a, b).Div(dualNorm(a).Mul(dualNorm(b))).Acos()
+	}, append(u[:], v[:]...)...)
+
+	if angle := u.AngleBetween(v); !almostEqual(angle, acos) {
+		t.Fatalf("Angle between vectors is %v by Acos, got %v", acos, angle)
+	}
+
+	if angle := u.AngleBetween(u.Scale(Uncertain{2, 0})); angle.Value != 0 || math.Abs(angle.Error-0.0311305933605448) > 1e-15 {
+		t.Fatalf("Angle between parallel vectors is 0±0.0311, got %v", angle)
+	}
+	if angle := u.AngleBetween(u.Scale(Uncertain{-2, 0})); angle.Value != math.Pi || math.Abs(angle.Error-0.0311305933605448) > 1e-15 {
+		t.Fatalf("Angle between opposite vectors is π±0.0311, got %v", angle)
+	}
+	if angle := (Vec2{{1, 0}, {0, 0.1}}).AngleBetween(Vec2{{1, 0}, {0, 0}}); angle != (Uncertain{0, 0.1}) {
+		t.Fatalf("Angle between (1, 0±0.1) and (1, 0) is 0±0.1, got %v", angle)
+	}
+	near := (Vec2{{1, 0}, {1e-9, 0.1}}).AngleBetween(Vec2{{1, 0}, {0, 0}})
+	if math.Abs(near.Error-0.1) > 1e-9 {
+		t.Fatalf("Angle between (1, 1e-9±0.1) and (1, 0) is 0±0.1, got %v", near)
+	}
+	parallel := Vec2{{1, 0.1}, {0, 0.1}}
+	limit := (Vec2{{1, 0.1}, {1e-12, 0.1}}).AngleBetween(parallel)
+	if angle := parallel.AngleBetween(parallel); angle.Value != 0 || math.Abs(angle.Error-limit.Error) > 1e-9 || math.Abs(angle.Error-0.2) > 1e-15 {
+		t.Fatalf("Angle between parallel (1±0.1, 0±0.1) is 0±0.2 as in the limit %v, got %v", limit, angle)
+	}
+	if angle := (Vec3{{1, 0.1}, {0, 0}, {0, 0}}).AngleBetween(Vec3{{2, 0}, {0, 0}, {0, 0}}); angle != (Uncertain{0, 0}) {
+		t.Fatalf("Parallel errors do not change the angle, got %v", angle)
+	}
+	if angle := (Vec2{{0, 0.1}, {0, 0}}).AngleBetween(Vec2{{1, 0}, {0, 0}}); !math.IsInf(angle.Error, 1) {
+		t.Fatalf("Angle with the uncertain zero vector has infinite error, got %v", angle)
+	}
+
+	w := VecN{X: []Uncertain{{3, 0.1}, {4, 0.1}}, Cov: [][]float64{{0.01, 0.01}, {0.01, 0.01}}}
+	if angle := w.AngleBetween(VecN{X: []Uncertain{{6, 0}, {8, 0}}}); math.Abs(angle.Error-0.1/25) > 1e-15 {
+		t.Fatalf("Angle with covariance is 0±%f, got %v", 0.1/25, angle)
+	}
+	if angle := (Vec2{{1, 0}, {0, 0}}).AngleBetween(Vec2{{-2, 0}, {0, 0}}); angle != (Uncertain{math.Pi, 0}) {
+		t.Fatalf("Angle between exact opposite vectors is π±0, got %v", angle)
+	}
+}
+
+func TestNormalizeZero(t *testing.T) {
+	if n := (Vec2{{0, 0}, {0, 0}}).Normalize(); !math.IsNaN(n[0].Value) || n[0].Error != 0 || !math.IsNaN(n[1].Value) || n[1].Error != 0 {
+		t.Fatalf("Normalized exact zero vector is (NaN±0, NaN±0), got %v", n)
+	}
+	if n := (Vec2{{0, 0.1}, {0, 0}}).Normalize(); !math.IsNaN(n[0].Error) || !math.IsNaN(n[1].Error) {
+		t.Fatalf("Normalized uncertain zero vector is (NaN±NaN, NaN±NaN), got %v", n)
+	}
+	n := VecN{X: []Uncertain{{0, 0.1}, {0, 0.1}}, Cov: [][]float64{{0.01, 0}, {0, 0.01}}}.Normalize()
+	if !math.IsNaN(n.X[0].Error) || !math.IsNaN(n.Cov[0][1]) {
+		t.Fatalf("Normalized uncertain zero vector with covariance is NaN, got %v", n)
+	}
+}
+
+func TestVectorCovariance(t *testing.T) {
+	correlated := VecN{X: []Uncertain{{1, 0.1}, {1, 0.1}}, Cov: [][]float64{{0.01, 0.01}, {0.01, 0.01}}}
+	independent := VecN{X: []Uncertain{{1, 0.1}, {1, 0.1}}, Cov: [][]float64{{0.01, 0}, {0, 0.01}}}
+	ones := VecN{X: []Uncertain{{1, 0}, {1, 0}}}
+
+	if sum := correlated.Dot(ones); !almostEqual(sum, Uncertain{2, 0.2}) {
+		t.Fatalf("Sum of fully correlated 1±0.1 and 1±0.1 is 2±0.2, got %v", sum)
+	}
+	if sum := independent.Dot(ones); !almostEqual(sum, Uncertain{2, 0.1 * math.Sqrt2}) {
+		t.Fatalf("Sum of independent 1±0.1 and 1±0.1 is 2±0.14, got %v", sum)
+	}
+	if diff := correlated.Dot(VecN{X: []Uncertain{{1, 0}, {-1, 0}}}); diff != (Uncertain{0, 0}) {
+		t.Fatalf("Difference of fully correlated 1±0.1 and 1±0.1 is 0±0, got %v", diff)
+	}
+
+	n := VecN{X: []Uncertain{{3, 0.1}, {4, 0.1}}, Cov: [][]float64{{0.01, 0}, {0, 0.01}}}.Normalize()
+	cov := [][]float64{{0.000256, -0.000192}, {-0.000192, 0.000144}}
+	for i := range cov {
+		for j := range cov {
+			if math.Abs(n.Cov[i][j]-cov[i][j]) > 1e-15 {
+				t.Fatalf("Covariance of normalized (3, 4) with isotropic error 0.1 is %v, got %v", cov, n.Cov)
+			}
+		}
+	}
+	if !almostEqual(n.X[1], Uncertain{0.8, 0.012}) {
+		t.Fatalf("Second component of normalized (3, 4) is 0.8±0.012, got %v", n.X[1])
+	}
+
+	sum := correlated.Add(ones.Scale(Uncertain{1, 0.1}))
+	if sum.Cov == nil || math.Abs(sum.Cov[0][1]-0.01) > 1e-15 || !almostEqual(sum.X[0], Uncertain{2, math.Sqrt(0.02)}) {
+		t.Fatalf("Covariance of sum is wrong: %v, %v", sum.X, sum.Cov)
+	}
+}